@@ -0,0 +1,75 @@
+/**
+ * Description：
+ * FileName：converter.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 16:48:13
+ * Remark：
+ */
+
+package copier
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"reflect"
+	"time"
+)
+
+// Config 复制时的可选配置，通过 WithConverters 设置
+type Config struct {
+	converters map[typePair]func(src reflect.Value) (reflect.Value, error)
+}
+
+// Converter 从 Src 类型到 Dst 类型的自定义转换，参考 NewConverter
+type Converter struct {
+	src reflect.Type
+	dst reflect.Type
+	fn  func(src reflect.Value) (reflect.Value, error)
+}
+
+// NewConverter 创建 Converter，复制过程中遇到 Src 类型到 Dst 类型时使用 fn 转换
+// 转换器的优先级最高，也可以用于覆盖默认的复制规则
+func NewConverter[Src any, Dst any](fn func(src Src) (Dst, error)) Converter {
+	return Converter{
+		src: reflect.TypeOf((*Src)(nil)).Elem(),
+		dst: reflect.TypeOf((*Dst)(nil)).Elem(),
+		fn: func(src reflect.Value) (reflect.Value, error) {
+			res, err := fn(src.Interface().(Src))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return reflect.ValueOf(&res).Elem(), nil
+		},
+	}
+}
+
+// WithConverters 注册自定义转换器，相同类型的转换器后注册的覆盖先注册的
+func WithConverters(converters ...Converter) option.Option[Config] {
+	return func(c *Config) {
+		if c.converters == nil {
+			c.converters = make(map[typePair]func(src reflect.Value) (reflect.Value, error), len(converters))
+		}
+		for _, conv := range converters {
+			c.converters[typePair{src: conv.src, dst: conv.dst}] = conv.fn
+		}
+	}
+}
+
+// TimeToString 使用 layout 将 time.Time 格式化为字符串，零值转换为空字符串
+func TimeToString(layout string) Converter {
+	return NewConverter(func(src time.Time) (string, error) {
+		if src.IsZero() {
+			return "", nil
+		}
+		return src.Format(layout), nil
+	})
+}
+
+// StringToTime 使用 layout 将字符串解析为 time.Time，空字符串转换为零值
+func StringToTime(layout string) Converter {
+	return NewConverter(func(src string) (time.Time, error) {
+		if src == "" {
+			return time.Time{}, nil
+		}
+		return time.Parse(layout, src)
+	})
+}
@@ -0,0 +1,418 @@
+/**
+ * Description：
+ * FileName：copier.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 16:02:35
+ * Remark：
+ */
+
+package copier
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/internal/reflectutil"
+	"reflect"
+	"sync"
+)
+
+// TagName 用于重命名或者忽略字段的结构体标签
+//   - `copier:"Name"` 使用 Name 作为字段的匹配名称，两侧的匹配名称相同的字段会被复制
+//   - `copier:"-"` 忽略该字段，无论它出现在源结构体还是目标结构体中
+const TagName = "copier"
+
+// copyFunc 将 src 复制到 dst，dst 必须是可以设置的
+type copyFunc func(dst, src reflect.Value) error
+
+type typePair struct {
+	src reflect.Type
+	dst reflect.Type
+}
+
+// Copier 预先计算好字段映射的复制器，构建完成后可以被多个 goroutine 并发使用
+//
+// 复制规则（按顺序匹配）：
+//  1. 注册了 (源类型, 目标类型) 的转换器时使用转换器
+//  2. 没有注册转换器时，不包含指针、切片、map 的相同类型直接赋值
+//  3. 指针：nil 复制为零值，否则分配新的对象并复制指向的值，指针与非指针之间可以互相复制
+//  4. 结构体：按照字段的匹配名称（字段名或者标签）逐个复制导出字段，目标结构体中没有匹配的字段保持不变
+//  5. 切片、数组、map：创建新的容器并逐个复制元素，nil 复制为 nil
+//  6. 相同种类的基础类型（例如 type Status int 与 int）以及数字之间的转换，数字溢出时返回错误；
+//     浮点数之间的转换允许精度损失，例如 float64 的 0.1 复制到 float32
+//
+// 类型不兼容时 NewCopier 返回错误，因此复制过程中的错误只来自转换器和数字溢出，类型为 *FieldError
+type Copier[Src any, Dst any] struct {
+	fn copyFunc
+}
+
+// NewCopier 创建 Copier，源类型与目标类型不兼容时返回错误
+func NewCopier[Src any, Dst any](opts ...option.Option[Config]) (*Copier[Src, Dst], error) {
+	cfg := &Config{}
+	option.Apply(cfg, opts...)
+	c := &compiler{cfg: cfg, cache: map[typePair]*copyFunc{}}
+	fn, err := c.compile(reflect.TypeOf((*Src)(nil)).Elem(), reflect.TypeOf((*Dst)(nil)).Elem(), "")
+	if err != nil {
+		return nil, err
+	}
+	return &Copier[Src, Dst]{fn: fn}, nil
+}
+
+// Copy 将 src 复制为一个新的 Dst
+func (c *Copier[Src, Dst]) Copy(src Src) (Dst, error) {
+	var dst Dst
+	err := c.CopyTo(&dst, src)
+	return dst, err
+}
+
+// CopyTo 将 src 复制到已经存在的 dst 中，dst 中没有匹配的字段保持不变
+func (c *Copier[Src, Dst]) CopyTo(dst *Dst, src Src) error {
+	if err := c.fn(reflect.ValueOf(dst).Elem(), reflect.ValueOf(&src).Elem()); err != nil {
+		return WithPath(err, "")
+	}
+	return nil
+}
+
+var defaultCopiers sync.Map
+
+// Copy 将 src 复制为一个新的 Dst，没有 opts 时会缓存 Copier
+// 需要重复复制相同类型时，优先使用 NewCopier 创建的 Copier
+func Copy[Src any, Dst any](src Src, opts ...option.Option[Config]) (Dst, error) {
+	if len(opts) > 0 {
+		c, err := NewCopier[Src, Dst](opts...)
+		if err != nil {
+			var dst Dst
+			return dst, err
+		}
+		return c.Copy(src)
+	}
+	key := typePair{src: reflect.TypeOf((*Src)(nil)).Elem(), dst: reflect.TypeOf((*Dst)(nil)).Elem()}
+	if v, ok := defaultCopiers.Load(key); ok {
+		return v.(*Copier[Src, Dst]).Copy(src)
+	}
+	c, err := NewCopier[Src, Dst]()
+	if err != nil {
+		var dst Dst
+		return dst, err
+	}
+	v, _ := defaultCopiers.LoadOrStore(key, c)
+	return v.(*Copier[Src, Dst]).Copy(src)
+}
+
+// compiler 根据类型生成 copyFunc，cache 用于处理递归类型
+type compiler struct {
+	cfg   *Config
+	cache map[typePair]*copyFunc
+}
+
+func (c *compiler) compile(src, dst reflect.Type, path string) (copyFunc, error) {
+	key := typePair{src: src, dst: dst}
+	if fp, ok := c.cache[key]; ok {
+		// 递归类型在生成完成之前就会被引用，因此通过指针间接调用
+		return func(d, s reflect.Value) error {
+			return (*fp)(d, s)
+		}, nil
+	}
+	fp := new(copyFunc)
+	c.cache[key] = fp
+	fn, err := c.build(src, dst, path)
+	if err != nil {
+		delete(c.cache, key)
+		return nil, err
+	}
+	*fp = fn
+	return fn, nil
+}
+
+func (c *compiler) build(src, dst reflect.Type, path string) (copyFunc, error) {
+	if conv, ok := c.cfg.converters[typePair{src: src, dst: dst}]; ok {
+		return func(d, s reflect.Value) error {
+			res, err := conv(s)
+			if err != nil {
+				return err
+			}
+			d.Set(res)
+			return nil
+		}, nil
+	}
+	// 注册了转换器时，相同类型的字段也可能需要转换，不能整体赋值
+	if src == dst && len(c.cfg.converters) == 0 && isValueType(src) {
+		return func(d, s reflect.Value) error {
+			d.Set(s)
+			return nil
+		}, nil
+	}
+
+	switch {
+	case src.Kind() == reflect.Pointer && dst.Kind() == reflect.Pointer:
+		elem, err := c.compile(src.Elem(), dst.Elem(), path)
+		if err != nil {
+			return nil, err
+		}
+		return func(d, s reflect.Value) error {
+			if s.IsNil() {
+				d.SetZero()
+				return nil
+			}
+			nv := reflect.New(dst.Elem())
+			if err := elem(nv.Elem(), s.Elem()); err != nil {
+				return err
+			}
+			d.Set(nv)
+			return nil
+		}, nil
+	case src.Kind() == reflect.Pointer:
+		elem, err := c.compile(src.Elem(), dst, path)
+		if err != nil {
+			return nil, err
+		}
+		return func(d, s reflect.Value) error {
+			if s.IsNil() {
+				d.SetZero()
+				return nil
+			}
+			return elem(d, s.Elem())
+		}, nil
+	case dst.Kind() == reflect.Pointer:
+		elem, err := c.compile(src, dst.Elem(), path)
+		if err != nil {
+			return nil, err
+		}
+		return func(d, s reflect.Value) error {
+			nv := reflect.New(dst.Elem())
+			if err := elem(nv.Elem(), s); err != nil {
+				return err
+			}
+			d.Set(nv)
+			return nil
+		}, nil
+	}
+
+	switch {
+	case src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct && !isOpaque(src) && !isOpaque(dst):
+		return c.buildStruct(src, dst, path)
+	case (src.Kind() == reflect.Slice || src.Kind() == reflect.Array) && dst.Kind() == reflect.Slice:
+		return c.buildSlice(src, dst, path)
+	case src.Kind() == reflect.Array && dst.Kind() == reflect.Array && src.Len() == dst.Len():
+		elem, err := c.compile(src.Elem(), dst.Elem(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+		return func(d, s reflect.Value) error {
+			for i := 0; i < s.Len(); i++ {
+				if err := elem(d.Index(i), s.Index(i)); err != nil {
+					return WithPath(err, "[]")
+				}
+			}
+			return nil
+		}, nil
+	case src.Kind() == reflect.Map && dst.Kind() == reflect.Map:
+		return c.buildMap(src, dst, path)
+	case src.AssignableTo(dst) && !isContainer(src):
+		// 接口、函数、通道以及没有导出字段的结构体只能浅拷贝
+		return func(d, s reflect.Value) error {
+			d.Set(s)
+			return nil
+		}, nil
+	case reflectutil.IsNumber(src.Kind()) && reflectutil.IsNumber(dst.Kind()):
+		return func(d, s reflect.Value) error {
+			res, ok := reflectutil.ConvertNumber(s, dst)
+			if !ok {
+				return InvalidValue(dst.String(), s.Interface())
+			}
+			d.Set(res)
+			return nil
+		}, nil
+	case src.Kind() == dst.Kind() && reflectutil.IsBasic(src.Kind()):
+		return func(d, s reflect.Value) error {
+			d.Set(s.Convert(dst))
+			return nil
+		}, nil
+	}
+	return nil, errs.NewErrCopyField(fieldName(path), errs.NewErrInvalidType(dst.String(), src.String()))
+}
+
+// structField 一个需要复制的字段
+type structField struct {
+	name string
+	dst  []int
+	src  []int
+	fn   copyFunc
+}
+
+func (c *compiler) buildStruct(src, dst reflect.Type, path string) (copyFunc, error) {
+	srcFields := make(map[string]fieldInfo)
+	for _, f := range copyFields(src) {
+		srcFields[f.name] = f
+	}
+	var fields []structField
+	for _, df := range copyFields(dst) {
+		sf, ok := srcFields[df.name]
+		if !ok {
+			continue
+		}
+		fn, err := c.compile(sf.typ, df.typ, reflectutil.JoinPath(path, df.name))
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, structField{name: df.name, dst: df.index, src: sf.index, fn: fn})
+	}
+	return func(d, s reflect.Value) error {
+		for _, f := range fields {
+			if err := f.fn(d.FieldByIndex(f.dst), s.FieldByIndex(f.src)); err != nil {
+				return WithPath(err, f.name)
+			}
+		}
+		return nil
+	}, nil
+}
+
+func (c *compiler) buildSlice(src, dst reflect.Type, path string) (copyFunc, error) {
+	elem, err := c.compile(src.Elem(), dst.Elem(), path+"[]")
+	if err != nil {
+		return nil, err
+	}
+	return func(d, s reflect.Value) error {
+		if s.Kind() == reflect.Slice && s.IsNil() {
+			d.SetZero()
+			return nil
+		}
+		res := reflect.MakeSlice(dst, s.Len(), s.Len())
+		for i := 0; i < s.Len(); i++ {
+			if err := elem(res.Index(i), s.Index(i)); err != nil {
+				return WithPath(err, "[]")
+			}
+		}
+		d.Set(res)
+		return nil
+	}, nil
+}
+
+func (c *compiler) buildMap(src, dst reflect.Type, path string) (copyFunc, error) {
+	key, err := c.compile(src.Key(), dst.Key(), path+"[key]")
+	if err != nil {
+		return nil, err
+	}
+	val, err := c.compile(src.Elem(), dst.Elem(), path+"[]")
+	if err != nil {
+		return nil, err
+	}
+	return func(d, s reflect.Value) error {
+		if s.IsNil() {
+			d.SetZero()
+			return nil
+		}
+		res := reflect.MakeMapWithSize(dst, s.Len())
+		k := reflect.New(dst.Key()).Elem()
+		v := reflect.New(dst.Elem()).Elem()
+		iter := s.MapRange()
+		for iter.Next() {
+			// 复用的 k 和 v 需要清空，避免残留上一个元素中没有被复制的字段
+			k.SetZero()
+			v.SetZero()
+			if err := key(k, iter.Key()); err != nil {
+				return WithPath(err, "[key]")
+			}
+			if err := val(v, iter.Value()); err != nil {
+				return WithPath(err, "[]")
+			}
+			res.SetMapIndex(k, v)
+		}
+		d.Set(res)
+		return nil
+	}, nil
+}
+
+// fieldInfo 参与复制的字段，name 为匹配名称
+type fieldInfo struct {
+	name  string
+	index []int
+	typ   reflect.Type
+}
+
+// copyFields 按照字段的顺序返回结构体中所有参与复制的字段
+// 没有标签的嵌入结构体（非指针）的字段会被提升，外层的同名字段优先
+func copyFields(typ reflect.Type) []fieldInfo {
+	var res []fieldInfo
+	seen := make(map[string]struct{})
+	collectFields(typ, nil, seen, &res)
+	return res
+}
+
+func collectFields(typ reflect.Type, index []int, seen map[string]struct{}, res *[]fieldInfo) {
+	var embedded []int
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag := f.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, i)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag != "" {
+			name = tag
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		*res = append(*res, fieldInfo{
+			name:  name,
+			index: append(index[:len(index):len(index)], i),
+			typ:   f.Type,
+		})
+	}
+	for _, i := range embedded {
+		collectFields(typ.Field(i).Type, append(index[:len(index):len(index)], i), seen, res)
+	}
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+// isValueType 类型中是否不包含需要深拷贝的指针、切片、map
+func isValueType(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		return false
+	case reflect.Array:
+		return isValueType(typ.Elem())
+	case reflect.Struct:
+		if isOpaque(typ) {
+			return true
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			if !isValueType(typ.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// isOpaque 没有导出字段的结构体（例如 time.Time）被视为一个整体
+func isOpaque(typ reflect.Type) bool {
+	return typ.NumField() > 0 && !reflectutil.HasExported(typ)
+}
+
+func isContainer(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Array:
+		return true
+	case reflect.Struct:
+		return !isOpaque(typ)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,286 @@
+/**
+ * Description：
+ * FileName：copier_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 17:06:42
+ * Remark：
+ */
+
+package copier
+
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+	"time"
+)
+
+type status int
+
+type base struct {
+	CreatedBy string
+}
+
+type address struct {
+	City string
+	Zip  string
+}
+
+type addressDTO struct {
+	City string
+}
+
+type userEntity struct {
+	base
+	ID        int64
+	Name      string
+	Email     string `copier:"EmailAddress"`
+	Password  string `copier:"-"`
+	Age       int32
+	Status    status
+	Tags      []string
+	Address   *address
+	Scores    map[string]int
+	CreatedAt time.Time
+	Friends   []userEntity
+	secret    string
+}
+
+type userDTO struct {
+	ID           int64
+	Name         string
+	EmailAddress string
+	Password     string
+	Age          int64
+	Status       int
+	Tags         []string
+	Address      addressDTO
+	Scores       map[string]int64
+	CreatedAt    string
+	Friends      []*userDTO
+	CreatedBy    string
+	Extra        string
+}
+
+type node struct {
+	Val  int
+	Next *node
+}
+
+func TestCopy(t *testing.T) {
+	createdAt := time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC)
+	src := userEntity{
+		base:      base{CreatedBy: "admin"},
+		ID:        1,
+		Name:      "Tom",
+		Email:     "tom@example.com",
+		Password:  "123456",
+		Age:       18,
+		Status:    2,
+		Tags:      []string{"a", "b"},
+		Address:   &address{City: "上海", Zip: "200000"},
+		Scores:    map[string]int{"math": 90},
+		CreatedAt: createdAt,
+		Friends:   []userEntity{{ID: 2, Name: "Jerry"}},
+		secret:    "secret",
+	}
+	c, err := NewCopier[userEntity, userDTO](WithConverters(TimeToString(time.RFC3339)))
+	require.NoError(t, err)
+	dst, err := c.Copy(src)
+	require.NoError(t, err)
+	assert.Equal(t, userDTO{
+		ID:           1,
+		Name:         "Tom",
+		EmailAddress: "tom@example.com",
+		Age:          18,
+		Status:       2,
+		Tags:         []string{"a", "b"},
+		Address:      addressDTO{City: "上海"},
+		Scores:       map[string]int64{"math": 90},
+		CreatedAt:    "2026-10-20T08:00:00Z",
+		Friends:      []*userDTO{{ID: 2, Name: "Jerry"}},
+		CreatedBy:    "admin",
+	}, dst)
+
+	// 深拷贝，修改源对象不会影响复制的结果
+	src.Tags[0] = "changed"
+	src.Scores["math"] = 0
+	assert.Equal(t, []string{"a", "b"}, dst.Tags)
+	assert.Equal(t, map[string]int64{"math": 90}, dst.Scores)
+
+	// 反向复制
+	back, err := Copy[userDTO, userEntity](dst, WithConverters(StringToTime(time.RFC3339)))
+	require.NoError(t, err)
+	assert.Equal(t, createdAt, back.CreatedAt)
+	assert.Equal(t, &address{City: "上海"}, back.Address)
+	assert.Equal(t, "admin", back.CreatedBy)
+	assert.Equal(t, "", back.Password)
+}
+
+func TestCopyTypes(t *testing.T) {
+	t.Run("same type deep copy", func(t *testing.T) {
+		src := &node{Val: 1, Next: &node{Val: 2}}
+		dst, err := Copy[*node, *node](src)
+		require.NoError(t, err)
+		assert.Equal(t, src, dst)
+		assert.NotSame(t, src.Next, dst.Next)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		dst, err := Copy[*node, *node](nil)
+		require.NoError(t, err)
+		assert.Nil(t, dst)
+
+		res, err := Copy[userEntity, userDTO](userEntity{}, WithConverters(TimeToString(time.RFC3339)))
+		require.NoError(t, err)
+		assert.Nil(t, res.Tags)
+		assert.Nil(t, res.Scores)
+	})
+
+	t.Run("slice of structs", func(t *testing.T) {
+		dst, err := Copy[[]address, []addressDTO]([]address{{City: "a", Zip: "1"}, {City: "b"}})
+		require.NoError(t, err)
+		assert.Equal(t, []addressDTO{{City: "a"}, {City: "b"}}, dst)
+	})
+
+	t.Run("array to slice", func(t *testing.T) {
+		dst, err := Copy[[2]int32, []int64]([2]int32{1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, dst)
+	})
+
+	t.Run("map of structs", func(t *testing.T) {
+		dst, err := Copy[map[string]*address, map[string]addressDTO](map[string]*address{
+			"home": {City: "a", Zip: "1"},
+			"work": nil,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]addressDTO{"home": {City: "a"}, "work": {}}, dst)
+	})
+
+	t.Run("value to pointer", func(t *testing.T) {
+		dst, err := Copy[address, *addressDTO](address{City: "a"})
+		require.NoError(t, err)
+		assert.Equal(t, &addressDTO{City: "a"}, dst)
+	})
+
+	t.Run("float64 to float32", func(t *testing.T) {
+		type price64 struct {
+			Price float64
+		}
+		type price32 struct {
+			Price float32
+		}
+		// 精度损失不是溢出
+		dst, err := Copy[price64, price32](price64{Price: 0.1})
+		require.NoError(t, err)
+		assert.Equal(t, price32{Price: 0.1}, dst)
+
+		dst, err = Copy[price64, price32](price64{Price: math.Inf(1)})
+		require.NoError(t, err)
+		assert.Equal(t, price32{Price: float32(math.Inf(1))}, dst)
+
+		_, err = Copy[price64, price32](price64{Price: 1e39})
+		assert.Equal(t, &FieldError{Path: "Price", Err: errs.NewErrInvalidType("float32", 1e39)}, err)
+	})
+}
+
+func TestCopyTo(t *testing.T) {
+	c, err := NewCopier[address, userDTO]()
+	require.NoError(t, err)
+	dst := userDTO{Name: "keep", Extra: "keep"}
+	require.NoError(t, c.CopyTo(&dst, address{City: "a"}))
+	assert.Equal(t, userDTO{Name: "keep", Extra: "keep"}, dst)
+}
+
+func TestCopyErr(t *testing.T) {
+	type badAge struct {
+		Age string
+	}
+	type smallAge struct {
+		Age int8
+	}
+	type unsignedAge struct {
+		Age uint
+	}
+	type timeStr struct {
+		CreatedAt string
+	}
+	type timeVal struct {
+		CreatedAt time.Time
+	}
+
+	_, err := NewCopier[userEntity, badAge]()
+	assert.Equal(t, errs.NewErrCopyField("Age", errs.NewErrInvalidType("string", "int32")), err)
+
+	_, err = NewCopier[userEntity, userDTO]()
+	assert.Equal(t, errs.NewErrCopyField("CreatedAt", errs.NewErrInvalidType("string", "time.Time")), err)
+
+	_, err = NewCopier[[]int, map[int]int]()
+	assert.Equal(t, errs.NewErrCopyField(".", errs.NewErrInvalidType("map[int]int", "[]int")), err)
+
+	_, err = Copy[userEntity, smallAge](userEntity{Age: 300})
+	assert.Equal(t, &FieldError{Path: "Age", Err: errs.NewErrInvalidType("int8", int32(300))}, err)
+	assert.EqualError(t, err, errs.NewErrCopyField("Age", errs.NewErrInvalidType("int8", int32(300))).Error())
+
+	_, err = Copy[smallAge, unsignedAge](smallAge{Age: -1})
+	assert.Equal(t, &FieldError{Path: "Age", Err: errs.NewErrInvalidType("uint", int8(-1))}, err)
+
+	_, err = Copy[map[string][]smallAge, map[string][]unsignedAge](map[string][]smallAge{"a": {{Age: -1}}})
+	assert.Equal(t, &FieldError{Path: "[][].Age", Err: errs.NewErrInvalidType("uint", int8(-1))}, err)
+
+	_, err = Copy[map[int64]int, map[int8]int](map[int64]int{1000: 1})
+	assert.Equal(t, &FieldError{Path: "[key]", Err: errs.NewErrInvalidType("int8", int64(1000))}, err)
+
+	_, err = Copy[int, uint](-1)
+	assert.EqualError(t, err, errs.NewErrCopyField(".", errs.NewErrInvalidType("uint", -1)).Error())
+
+	_, err = Copy[[]timeStr, []timeVal]([]timeStr{{CreatedAt: "yesterday"}}, WithConverters(StringToTime(time.RFC3339)))
+	var fe *FieldError
+	require.ErrorAs(t, err, &fe)
+	assert.Equal(t, "[].CreatedAt", fe.Path)
+	assert.ErrorContains(t, err, "echo: 复制字段 [].CreatedAt 失败")
+
+	// 自定义转换器覆盖默认规则
+	res, err := Copy[smallAge, smallAge](smallAge{Age: 1}, WithConverters(NewConverter(func(src int8) (int8, error) {
+		return src * 2, nil
+	})))
+	require.NoError(t, err)
+	assert.Equal(t, smallAge{Age: 2}, res)
+}
+
+func TestCopyConcurrent(t *testing.T) {
+	done := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			_, err := Copy[[]address, []addressDTO]([]address{{City: "a"}})
+			assert.NoError(t, err)
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+}
+
+func BenchmarkCopier(b *testing.B) {
+	src := userEntity{
+		ID:        1,
+		Name:      "Tom",
+		Email:     "tom@example.com",
+		Age:       18,
+		Tags:      []string{"a", "b"},
+		Address:   &address{City: "上海"},
+		Scores:    map[string]int{"math": 90},
+		CreatedAt: time.Now(),
+	}
+	c, err := NewCopier[userEntity, userDTO](WithConverters(TimeToString(time.RFC3339)))
+	require.NoError(b, err)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.Copy(src)
+	}
+}
@@ -0,0 +1,56 @@
+/**
+ * Description：
+ * FileName：error.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 19:12:27
+ * Remark：
+ */
+
+package copier
+
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"strings"
+)
+
+// FieldError 复制过程中发生的错误，例如数字溢出或者转换器返回的错误
+// Path 为从根类型开始的字段路径，例如 "Friends[].Age"，根类型本身为 ""
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return errs.NewErrCopyField(fieldName(e.Path), e.Err).Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// WithPath 在错误的字段路径前面加上 segment，segment 为字段名称或者 "[]"、"[key]"
+// err 不是 *FieldError 时会被包装为 *FieldError，供 echo-gen 生成的代码使用
+func WithPath(err error, segment string) error {
+	fe, ok := err.(*FieldError)
+	if !ok {
+		fe = &FieldError{Err: err}
+	}
+	fe.Path = prependPath(segment, fe.Path)
+	return fe
+}
+
+// InvalidValue 返回值 got 无法转换为 want 类型的错误，供 echo-gen 生成的代码使用
+func InvalidValue(want string, got any) error {
+	return errs.NewErrInvalidType(want, got)
+}
+
+func prependPath(segment, path string) string {
+	switch {
+	case segment == "":
+		return path
+	case path == "" || strings.HasPrefix(path, "["):
+		return segment + path
+	default:
+		return segment + "." + path
+	}
+}
@@ -0,0 +1,207 @@
+/**
+ * Description：
+ * FileName：diff.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 22:10:45
+ * Remark：
+ */
+
+package bean
+
+import (
+	"fmt"
+	"github.com/carefuly/careful-echo/internal/reflectutil"
+	"github.com/carefuly/careful-echo/slice"
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"reflect"
+	"sort"
+)
+
+// ChangeType 变化的类型
+type ChangeType int
+
+const (
+	// Modified 值被修改
+	Modified ChangeType = iota
+	// Added 新增的 map 键或者切片元素，Old 为 nil
+	Added
+	// Removed 删除的 map 键或者切片元素，New 为 nil
+	Removed
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case Modified:
+		return "modified"
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	default:
+		return fmt.Sprintf("ChangeType(%d)", int(t))
+	}
+}
+
+// Change 一个字段的变化
+type Change struct {
+	Type ChangeType
+	Old  any
+	New  any
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v => %v", c.Type, c.Old, c.New)
+}
+
+// Diff 比较 a 和 b，返回所有发生变化的字段，Key 为字段路径，Value 为变化
+//
+// 比较规则：
+//   - 结构体：逐个比较导出字段，路径使用 . 连接，例如 "Address.City"；嵌入结构体的字段被提升，路径中不包含嵌入结构体的名称
+//   - 指针、接口：比较指向的值，其中一个为 nil 时整体视为修改
+//   - 数组：按照下标比较，例如 "Codes[1]"
+//   - 切片：视为集合，通过 slice.DiffSetFunc 计算删除和新增的元素，路径为切片本身，顺序的变化和重复的元素会被忽略
+//   - map：按照键比较，例如 "Scores[math]"，nil 与空 map 相同
+//   - 带有 Equal(T) bool 方法的类型（例如 time.Time）使用该方法比较，没有导出字段的结构体使用 reflect.DeepEqual 比较
+//
+// 结果按照结构体字段的顺序排列，map 的键按照字符串形式排序，没有变化时返回 nil
+func Diff[T any](a, b T) []pair.Pair[string, Change] {
+	d := &differ{}
+	d.diff("", reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+	return d.changes
+}
+
+type differ struct {
+	changes []pair.Pair[string, Change]
+}
+
+func (d *differ) add(path string, typ ChangeType, oldVal, newVal any) {
+	d.changes = append(d.changes, pair.NewPair(path, Change{Type: typ, Old: oldVal, New: newVal}))
+}
+
+func (d *differ) diff(path string, a, b reflect.Value) {
+	if eq, ok := callEqual(a, b); ok {
+		if !eq {
+			d.add(path, Modified, a.Interface(), b.Interface())
+		}
+		return
+	}
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.add(path, Modified, a.Interface(), b.Interface())
+			}
+			return
+		}
+		if a.Pointer() != b.Pointer() {
+			d.diff(path, a.Elem(), b.Elem())
+		}
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() || a.Elem().Type() != b.Elem().Type() {
+			if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+				d.add(path, Modified, a.Interface(), b.Interface())
+			}
+			return
+		}
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		if !reflectutil.HasExported(a.Type()) {
+			d.leaf(path, a, b)
+			return
+		}
+		typ := a.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			switch {
+			case reflectutil.IsPromoted(f):
+				d.diff(path, a.Field(i), b.Field(i))
+			case f.IsExported():
+				d.diff(reflectutil.JoinPath(path, f.Name), a.Field(i), b.Field(i))
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			d.diff(fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i))
+		}
+	case reflect.Slice:
+		d.diffSlice(path, a, b)
+	case reflect.Map:
+		d.diffMap(path, a, b)
+	default:
+		d.leaf(path, a, b)
+	}
+}
+
+func (d *differ) leaf(path string, a, b reflect.Value) {
+	if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		d.add(path, Modified, a.Interface(), b.Interface())
+	}
+}
+
+func (d *differ) diffSlice(path string, a, b reflect.Value) {
+	as, bs := values(a), values(b)
+	for _, v := range slice.DiffSetFunc(as, bs, equal) {
+		d.add(path, Removed, v.Interface(), nil)
+	}
+	for _, v := range slice.DiffSetFunc(bs, as, equal) {
+		d.add(path, Added, nil, v.Interface())
+	}
+}
+
+func (d *differ) diffMap(path string, a, b reflect.Value) {
+	keys := a.MapKeys()
+	for _, k := range b.MapKeys() {
+		if !a.MapIndex(k).IsValid() {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	for _, k := range keys {
+		kp := fmt.Sprintf("%s[%v]", path, k.Interface())
+		av, bv := a.MapIndex(k), b.MapIndex(k)
+		switch {
+		case !bv.IsValid():
+			d.add(kp, Removed, av.Interface(), nil)
+		case !av.IsValid():
+			d.add(kp, Added, nil, bv.Interface())
+		default:
+			d.diff(kp, av, bv)
+		}
+	}
+}
+
+// equal 按照 Diff 的规则判断两个值是否相同
+func equal(a, b reflect.Value) bool {
+	d := &differ{}
+	d.diff("", a, b)
+	return len(d.changes) == 0
+}
+
+func values(v reflect.Value) []reflect.Value {
+	res := make([]reflect.Value, v.Len())
+	for i := range res {
+		res[i] = v.Index(i)
+	}
+	return res
+}
+
+// callEqual 调用 a 的 Equal(T) bool 方法，没有该方法时 ok 为 false
+func callEqual(a, b reflect.Value) (eq bool, ok bool) {
+	if !a.CanInterface() {
+		return false, false
+	}
+	m := a.MethodByName("Equal")
+	if !m.IsValid() {
+		return false, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.In(0) != a.Type() || mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	if a.Kind() == reflect.Pointer && (a.IsNil() || b.IsNil()) {
+		return a.IsNil() && b.IsNil(), true
+	}
+	return m.Call([]reflect.Value{b})[0].Bool(), true
+}
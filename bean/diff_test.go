@@ -0,0 +1,168 @@
+/**
+ * Description：
+ * FileName：diff_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 23:05:14
+ * Remark：
+ */
+
+package bean
+
+import (
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type audit struct {
+	CreatedBy string
+	UpdatedAt time.Time
+}
+
+type address struct {
+	City string
+	Zip  string
+}
+
+type user struct {
+	audit
+	ID      int64
+	Name    string
+	Age     int
+	Tags    []string
+	Address *address
+	Codes   [2]int
+	Scores  map[string]int
+	Extra   any
+	secret  string
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC)
+	base := func() user {
+		return user{
+			audit:   audit{CreatedBy: "admin", UpdatedAt: now},
+			ID:      1,
+			Name:    "Tom",
+			Age:     18,
+			Tags:    []string{"a", "b"},
+			Address: &address{City: "上海", Zip: "200000"},
+			Codes:   [2]int{1, 2},
+			Scores:  map[string]int{"math": 90, "art": 80},
+			Extra:   1,
+			secret:  "x",
+		}
+	}
+	testCases := []struct {
+		name   string
+		mutate func(u *user)
+		want   []pair.Pair[string, Change]
+	}{
+		{
+			name:   "equal",
+			mutate: func(u *user) {},
+		},
+		{
+			name: "ignore unexported fields and same instant",
+			mutate: func(u *user) {
+				u.secret = "y"
+				u.UpdatedAt = now.In(time.FixedZone("CST", 8*3600))
+				u.Address = &address{City: "上海", Zip: "200000"}
+				u.Tags = []string{"b", "a", "a"}
+			},
+		},
+		{
+			name: "fields",
+			mutate: func(u *user) {
+				u.Name = "Jerry"
+				u.Age = 20
+				u.CreatedBy = "root"
+				u.UpdatedAt = now.Add(time.Hour)
+			},
+			want: []pair.Pair[string, Change]{
+				pair.NewPair("CreatedBy", Change{Type: Modified, Old: "admin", New: "root"}),
+				pair.NewPair("UpdatedAt", Change{Type: Modified, Old: now, New: now.Add(time.Hour)}),
+				pair.NewPair("Name", Change{Type: Modified, Old: "Tom", New: "Jerry"}),
+				pair.NewPair("Age", Change{Type: Modified, Old: 18, New: 20}),
+			},
+		},
+		{
+			name: "nested",
+			mutate: func(u *user) {
+				u.Address.City = "北京"
+				u.Codes[1] = 3
+				u.Extra = 2
+			},
+			want: []pair.Pair[string, Change]{
+				pair.NewPair("Address.City", Change{Type: Modified, Old: "上海", New: "北京"}),
+				pair.NewPair("Codes[1]", Change{Type: Modified, Old: 2, New: 3}),
+				pair.NewPair("Extra", Change{Type: Modified, Old: 1, New: 2}),
+			},
+		},
+		{
+			name: "nil pointer",
+			mutate: func(u *user) {
+				u.Address = nil
+				u.Extra = "1"
+			},
+			want: []pair.Pair[string, Change]{
+				pair.NewPair("Address", Change{Type: Modified, Old: &address{City: "上海", Zip: "200000"}, New: (*address)(nil)}),
+				pair.NewPair("Extra", Change{Type: Modified, Old: 1, New: "1"}),
+			},
+		},
+		{
+			name: "slice",
+			mutate: func(u *user) {
+				u.Tags = []string{"b", "c", "d"}
+			},
+			want: []pair.Pair[string, Change]{
+				pair.NewPair("Tags", Change{Type: Removed, Old: "a"}),
+				pair.NewPair("Tags", Change{Type: Added, New: "c"}),
+				pair.NewPair("Tags", Change{Type: Added, New: "d"}),
+			},
+		},
+		{
+			name: "map",
+			mutate: func(u *user) {
+				u.Scores = map[string]int{"math": 95, "music": 70}
+			},
+			want: []pair.Pair[string, Change]{
+				pair.NewPair("Scores[art]", Change{Type: Removed, Old: 80}),
+				pair.NewPair("Scores[math]", Change{Type: Modified, Old: 90, New: 95}),
+				pair.NewPair("Scores[music]", Change{Type: Added, New: 70}),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := base(), base()
+			tc.mutate(&b)
+			assert.Equal(t, tc.want, Diff(a, b))
+		})
+	}
+}
+
+func TestDiffTypes(t *testing.T) {
+	// 切片中的结构体整体比较，修改的元素表现为删除和新增
+	got := Diff([]address{{City: "a"}, {City: "b"}}, []address{{City: "a"}, {City: "c"}})
+	assert.Equal(t, []pair.Pair[string, Change]{
+		pair.NewPair("", Change{Type: Removed, Old: address{City: "b"}}),
+		pair.NewPair("", Change{Type: Added, New: address{City: "c"}}),
+	}, got)
+
+	// nil 与空的切片、map 相同
+	assert.Nil(t, Diff(user{}, user{Tags: []string{}, Scores: map[string]int{}}))
+
+	// 指针类型
+	assert.Equal(t, []pair.Pair[string, Change]{
+		pair.NewPair("City", Change{Type: Modified, Old: "a", New: "b"}),
+	}, Diff(&address{City: "a"}, &address{City: "b"}))
+
+	// map 中嵌套的结构体
+	got = Diff(map[int]address{1: {City: "a"}}, map[int]address{1: {City: "b"}})
+	assert.Equal(t, []pair.Pair[string, Change]{
+		pair.NewPair("[1].City", Change{Type: Modified, Old: "a", New: "b"}),
+	}, got)
+	assert.Equal(t, "modified: a => b", got[0].Value.String())
+}
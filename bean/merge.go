@@ -0,0 +1,101 @@
+/**
+ * Description：
+ * FileName：merge.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 22:48:06
+ * Remark：
+ */
+
+package bean
+
+import (
+	"github.com/carefuly/careful-echo/internal/reflectutil"
+	"reflect"
+)
+
+// MergePolicy 合并策略，可以通过 | 组合，例如 KeepNonZero | AppendSlices
+type MergePolicy uint8
+
+const (
+	// Overwrite 默认策略，src 中的非零值覆盖 dst
+	Overwrite MergePolicy = 0
+	// KeepNonZero 保留 dst 中的非零值，只填充 dst 中的零值
+	KeepNonZero MergePolicy = 1 << iota
+	// AppendSlices 将 src 中的切片元素追加到 dst 的切片后面，而不是覆盖
+	AppendSlices
+)
+
+// Merge 将 src 深度合并到 dst 中
+//
+// 合并规则：
+//   - src 中的零值（包括 nil 以及长度为 0 的切片、map）总是被忽略，因此无法通过 Merge 将字段设置为零值
+//   - 结构体：逐个合并导出字段以及嵌入结构体的字段，没有导出字段的结构体（例如 time.Time）被视为一个整体
+//   - 指针：两者都不为 nil 且指向结构体时合并指向的结构体，否则视为一个整体，dst 会直接引用 src 中的对象
+//   - map：逐个合并 src 中的键，dst 中不存在的键直接设置，都存在的键递归合并，dst 中的 map 会被原地修改
+//   - 切片：AppendSlices 时追加到新的切片中，否则视为一个整体
+//   - 其余的值按照 policy 决定是否覆盖
+func Merge[T any](dst *T, src T, policy MergePolicy) {
+	merge(reflect.ValueOf(dst).Elem(), reflect.ValueOf(&src).Elem(), policy)
+}
+
+func merge(dst, src reflect.Value, policy MergePolicy) {
+	if isEmpty(src) {
+		return
+	}
+	switch dst.Kind() {
+	case reflect.Struct:
+		if reflectutil.HasExported(dst.Type()) {
+			typ := dst.Type()
+			for i := 0; i < typ.NumField(); i++ {
+				if f := typ.Field(i); f.IsExported() || reflectutil.IsPromoted(f) {
+					merge(dst.Field(i), src.Field(i), policy)
+				}
+			}
+			return
+		}
+	case reflect.Pointer:
+		if !dst.IsNil() && dst.Elem().Kind() == reflect.Struct {
+			merge(dst.Elem(), src.Elem(), policy)
+			return
+		}
+	case reflect.Map:
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(dst.Type(), src.Len()))
+		}
+		iter := src.MapRange()
+		for iter.Next() {
+			dv := dst.MapIndex(iter.Key())
+			if !dv.IsValid() {
+				dst.SetMapIndex(iter.Key(), iter.Value())
+				continue
+			}
+			// map 中的值无法寻址，合并到副本中再写回
+			v := reflect.New(dv.Type()).Elem()
+			v.Set(dv)
+			merge(v, iter.Value(), policy)
+			dst.SetMapIndex(iter.Key(), v)
+		}
+		return
+	case reflect.Slice:
+		if policy&AppendSlices != 0 {
+			// 使用新的底层数组，避免覆盖与 dst 共享底层数组的其它切片
+			res := reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len())
+			dst.Set(reflect.AppendSlice(reflect.AppendSlice(res, dst), src))
+			return
+		}
+	}
+	if policy&KeepNonZero != 0 && !isEmpty(dst) {
+		return
+	}
+	dst.Set(src)
+}
+
+// isEmpty 零值或者长度为 0 的切片、map
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
@@ -0,0 +1,112 @@
+/**
+ * Description：
+ * FileName：merge_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 23:26:40
+ * Remark：
+ */
+
+package bean
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	now := time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name   string
+		dst    user
+		src    user
+		policy MergePolicy
+		want   user
+	}{
+		{
+			name:   "overwrite",
+			dst:    user{ID: 1, Name: "Tom", Age: 18, Tags: []string{"a"}, audit: audit{CreatedBy: "admin"}},
+			src:    user{Name: "Jerry", Tags: []string{"b"}, audit: audit{UpdatedAt: now}},
+			policy: Overwrite,
+			want:   user{ID: 1, Name: "Jerry", Age: 18, Tags: []string{"b"}, audit: audit{CreatedBy: "admin", UpdatedAt: now}},
+		},
+		{
+			name:   "keep non zero",
+			dst:    user{ID: 1, Name: "Tom", Tags: []string{"a"}},
+			src:    user{ID: 2, Name: "Jerry", Age: 20, Tags: []string{"b"}, audit: audit{CreatedBy: "admin"}},
+			policy: KeepNonZero,
+			want:   user{ID: 1, Name: "Tom", Age: 20, Tags: []string{"a"}, audit: audit{CreatedBy: "admin"}},
+		},
+		{
+			name:   "append slices",
+			dst:    user{Name: "Tom", Tags: []string{"a"}},
+			src:    user{Name: "Jerry", Tags: []string{"b", "c"}},
+			policy: AppendSlices,
+			want:   user{Name: "Jerry", Tags: []string{"a", "b", "c"}},
+		},
+		{
+			name:   "keep non zero and append slices",
+			dst:    user{Name: "Tom", Tags: []string{"a"}},
+			src:    user{Name: "Jerry", Tags: []string{"b"}},
+			policy: KeepNonZero | AppendSlices,
+			want:   user{Name: "Tom", Tags: []string{"a", "b"}},
+		},
+		{
+			name:   "nested pointer",
+			dst:    user{Address: &address{City: "上海", Zip: "200000"}},
+			src:    user{Address: &address{City: "北京"}},
+			policy: Overwrite,
+			want:   user{Address: &address{City: "北京", Zip: "200000"}},
+		},
+		{
+			name:   "nil pointer",
+			dst:    user{},
+			src:    user{Address: &address{City: "北京"}},
+			policy: KeepNonZero,
+			want:   user{Address: &address{City: "北京"}},
+		},
+		{
+			name:   "map",
+			dst:    user{Scores: map[string]int{"math": 90, "art": 80}},
+			src:    user{Scores: map[string]int{"math": 95, "music": 70}},
+			policy: Overwrite,
+			want:   user{Scores: map[string]int{"math": 95, "art": 80, "music": 70}},
+		},
+		{
+			name:   "map keep non zero",
+			dst:    user{Scores: map[string]int{"math": 90, "art": 0}},
+			src:    user{Scores: map[string]int{"math": 95, "art": 70}},
+			policy: KeepNonZero,
+			want:   user{Scores: map[string]int{"math": 90, "art": 70}},
+		},
+		{
+			name:   "nil map",
+			dst:    user{},
+			src:    user{Scores: map[string]int{"math": 95}},
+			policy: Overwrite,
+			want:   user{Scores: map[string]int{"math": 95}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Merge(&tc.dst, tc.src, tc.policy)
+			assert.Equal(t, tc.want, tc.dst)
+		})
+	}
+}
+
+func TestMergeNested(t *testing.T) {
+	// map 中的结构体递归合并
+	dst := map[string]address{"home": {City: "上海", Zip: "200000"}}
+	Merge(&dst, map[string]address{"home": {City: "北京"}}, Overwrite)
+	assert.Equal(t, map[string]address{"home": {City: "北京", Zip: "200000"}}, dst)
+
+	// 追加时不会修改共享底层数组的切片
+	shared := make([]string, 1, 4)
+	shared[0] = "a"
+	other := append(shared, "x")
+	u := user{Tags: shared}
+	Merge(&u, user{Tags: []string{"b"}}, AppendSlices)
+	assert.Equal(t, []string{"a", "b"}, u.Tags)
+	assert.Equal(t, []string{"a", "x"}, other)
+}
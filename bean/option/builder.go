@@ -0,0 +1,124 @@
+/**
+ * Description：
+ * FileName：builder.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 12:38:14
+ * Remark：
+ */
+
+package option
+
+import (
+	"cmp"
+	"errors"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"slices"
+)
+
+// Validator 校验应用完所有 option 之后的 t
+type Validator[T any] func(t *T) error
+
+// Builder 统一 option 模式中的默认值与校验
+// 依次应用默认值、用户传入的 OptionErr 以及校验器，并返回合并后的所有错误
+// 构建完成之后 Builder 可以被多个 goroutine 并发使用，一般作为包级别变量复用
+type Builder[T any] struct {
+	defaults   []Option[T]
+	validators []Validator[T]
+}
+
+// NewBuilder 创建 Builder
+func NewBuilder[T any]() *Builder[T] {
+	return &Builder[T]{}
+}
+
+// Default 注册默认值，默认值总是在用户的 option 之前应用
+func (b *Builder[T]) Default(opts ...Option[T]) *Builder[T] {
+	b.defaults = append(b.defaults, opts...)
+	return b
+}
+
+// Validate 注册校验器，校验器在所有 option 应用完成之后按注册顺序执行
+func (b *Builder[T]) Validate(validators ...Validator[T]) *Builder[T] {
+	b.validators = append(b.validators, validators...)
+	return b
+}
+
+// Apply 依次应用默认值、opts 以及校验器
+// 与 ApplyErr 不同，某个 option 返回错误时不会中断，而是继续执行剩余的 option 和所有校验器，
+// 最终返回使用 errors.Join 合并的所有错误
+func (b *Builder[T]) Apply(t *T, opts ...OptionErr[T]) error {
+	Apply(t, b.defaults...)
+	var errList []error
+	for _, opt := range opts {
+		if err := opt(t); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	for _, v := range b.validators {
+		if err := v(t); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// Build 创建一个新的 T 并调用 Apply，存在错误时返回 nil
+func (b *Builder[T]) Build(opts ...OptionErr[T]) (*T, error) {
+	t := new(T)
+	if err := b.Apply(t, opts...); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Required 校验 get 返回的值不是零值
+func Required[T any, F comparable](name string, get func(t *T) F) Validator[T] {
+	return func(t *T) error {
+		var zero F
+		if get(t) == zero {
+			return errs.NewErrOptionRequired(name)
+		}
+		return nil
+	}
+}
+
+// Range 校验 get 返回的值在 [minVal, maxVal] 之间
+func Range[T any, F cmp.Ordered](name string, get func(t *T) F, minVal, maxVal F) Validator[T] {
+	return func(t *T) error {
+		if val := get(t); val < minVal || val > maxVal {
+			return errs.NewErrOptionOutOfRange(name, val, minVal, maxVal)
+		}
+		return nil
+	}
+}
+
+// OneOf 校验 get 返回的值是 values 中的一个
+func OneOf[T any, F comparable](name string, get func(t *T) F, values ...F) Validator[T] {
+	return func(t *T) error {
+		if val := get(t); !slices.Contains(values, val) {
+			return errs.NewErrOptionNotOneOf(name, val, values)
+		}
+		return nil
+	}
+}
+
+// Exclusive 校验互斥的一组配置项中最多只有一个被设置，一个都没有设置时不返回错误
+// names 与 set 一一对应，set[i] 返回 names[i] 对应的配置项是否被设置；两者长度不同时 panic
+// 需要其中恰好一个被设置时，可以再配合 Required 等校验器使用
+func Exclusive[T any](names []string, set ...func(t *T) bool) Validator[T] {
+	if len(names) != len(set) {
+		panic("echo: Exclusive 的 names 与 set 长度不一致")
+	}
+	return func(t *T) error {
+		var conflict []string
+		for i, isSet := range set {
+			if isSet(t) {
+				conflict = append(conflict, names[i])
+			}
+		}
+		if len(conflict) > 1 {
+			return errs.NewErrOptionConflict(conflict)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,206 @@
+/**
+ * Description：
+ * FileName：builder_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 12:57:31
+ * Remark：
+ */
+
+package option
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type pool struct {
+	name    string
+	workers int
+	mode    string
+	timeout time.Duration
+}
+
+func withPoolName(name string) OptionErr[pool] {
+	return func(p *pool) error {
+		p.name = name
+		return nil
+	}
+}
+
+func withWorkers(n int) OptionErr[pool] {
+	return func(p *pool) error {
+		p.workers = n
+		return nil
+	}
+}
+
+func withMode(mode string) OptionErr[pool] {
+	return func(p *pool) error {
+		p.mode = mode
+		return nil
+	}
+}
+
+func withTimeout(timeout time.Duration) OptionErr[pool] {
+	return func(p *pool) error {
+		if timeout <= 0 {
+			return errs.NewErrInvalidIntervalValue(timeout)
+		}
+		p.timeout = timeout
+		return nil
+	}
+}
+
+var poolBuilder = NewBuilder[pool]().
+	Default(func(p *pool) {
+		p.workers = 4
+		p.mode = "fifo"
+		p.timeout = time.Second
+	}).
+	Validate(
+		Required("name", func(p *pool) string { return p.name }),
+		Range("workers", func(p *pool) int { return p.workers }, 1, 64),
+		OneOf("mode", func(p *pool) string { return p.mode }, "fifo", "lifo"),
+	)
+
+func TestBuilder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    []OptionErr[pool]
+		want    *pool
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			opts: []OptionErr[pool]{withPoolName("p")},
+			want: &pool{name: "p", workers: 4, mode: "fifo", timeout: time.Second},
+		},
+		{
+			name: "override defaults",
+			opts: []OptionErr[pool]{withPoolName("p"), withWorkers(64), withMode("lifo"), withTimeout(time.Minute)},
+			want: &pool{name: "p", workers: 64, mode: "lifo", timeout: time.Minute},
+		},
+		{
+			name:    "required",
+			wantErr: errors.Join(errs.NewErrOptionRequired("name")),
+		},
+		{
+			name:    "range",
+			opts:    []OptionErr[pool]{withPoolName("p"), withWorkers(0)},
+			wantErr: errors.Join(errs.NewErrOptionOutOfRange("workers", 0, 1, 64)),
+		},
+		{
+			name:    "one of",
+			opts:    []OptionErr[pool]{withPoolName("p"), withMode("random")},
+			wantErr: errors.Join(errs.NewErrOptionNotOneOf("mode", "random", []string{"fifo", "lifo"})),
+		},
+		{
+			name: "aggregate option and validator errors",
+			opts: []OptionErr[pool]{withTimeout(-1), withWorkers(100), withMode("random")},
+			wantErr: errors.Join(
+				errs.NewErrInvalidIntervalValue(-1),
+				errs.NewErrOptionRequired("name"),
+				errs.NewErrOptionOutOfRange("workers", 100, 1, 64),
+				errs.NewErrOptionNotOneOf("mode", "random", []string{"fifo", "lifo"}),
+			),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := poolBuilder.Build(tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+type auth struct {
+	password string
+	token    string
+	certFile string
+}
+
+func TestExclusive(t *testing.T) {
+	b := NewBuilder[auth]().Validate(
+		Exclusive([]string{"password", "token", "certFile"},
+			func(a *auth) bool { return a.password != "" },
+			func(a *auth) bool { return a.token != "" },
+			func(a *auth) bool { return a.certFile != "" },
+		),
+	)
+	testCases := []struct {
+		name    string
+		opts    []OptionErr[auth]
+		wantErr error
+	}{
+		{
+			name: "nothing set",
+		},
+		{
+			name: "one set",
+			opts: []OptionErr[auth]{func(a *auth) error { a.token = "t"; return nil }},
+		},
+		{
+			name: "two set",
+			opts: []OptionErr[auth]{
+				func(a *auth) error { a.password = "p"; return nil },
+				func(a *auth) error { a.certFile = "c"; return nil },
+			},
+			wantErr: errors.Join(errs.NewErrOptionConflict([]string{"password", "certFile"})),
+		},
+		{
+			name: "all set",
+			opts: []OptionErr[auth]{
+				func(a *auth) error { a.password = "p"; a.token = "t"; a.certFile = "c"; return nil },
+			},
+			wantErr: errors.Join(errs.NewErrOptionConflict([]string{"password", "token", "certFile"})),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := b.Build(tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+
+	assert.Panics(t, func() {
+		Exclusive[auth]([]string{"password"})
+	})
+}
+
+func TestBuilderApply(t *testing.T) {
+	// 没有默认值和校验器时等价于不中断的 ApplyErr
+	u := &User{}
+	err := NewBuilder[User]().Apply(u, WithNameErr(""), WithAgeErr(18))
+	assert.Equal(t, errors.Join(errors.New("name 不能为空")), err)
+	assert.Equal(t, &User{age: 18}, u)
+
+	// 默认值在 option 之前应用，可以被 option 覆盖
+	u = &User{}
+	err = NewBuilder[User]().Default(WithName("default"), WithAge(1)).Apply(u, WithAgeErr(18))
+	assert.NoError(t, err)
+	assert.Equal(t, &User{name: "default", age: 18}, u)
+}
+
+func ExampleBuilder() {
+	b := NewBuilder[User]().
+		Default(WithAge(18)).
+		Validate(
+			Required("name", func(u *User) string { return u.name }),
+			Range("age", func(u *User) int { return u.age }, 1, 150),
+		)
+
+	u, err := b.Build(WithNameErr("Tom"))
+	fmt.Println(u, err)
+
+	_, err = b.Build(WithAgeErr(200))
+	fmt.Println(err)
+	// Output:
+	// &{Tom 18} <nil>
+	// echo: 缺少必填的配置项 name
+	// echo: 配置项 age 超出范围，取值范围 [1, 150], 实际值 200
+}
@@ -0,0 +1,148 @@
+/**
+ * Description：
+ * FileName：loader.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 14:26:51
+ * Remark：
+ */
+
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// Loader 从配置文件和环境变量中加载带有标签的字段（参考 TagName）
+//
+// 优先级从低到高依次为：
+//  1. Builder 中注册的默认值
+//  2. JSON / YAML / 配置文件，按照注册顺序应用，后注册的覆盖先注册的
+//  3. 环境变量
+//  4. 用户传入的 option
+//
+// 配置源中缺失的配置项保持原值，多余的键会被忽略
+type Loader[T any] struct {
+	files  []OptionErr[T]
+	env    bool
+	prefix string
+}
+
+// NewLoader 创建 Loader
+func NewLoader[T any]() *Loader[T] {
+	return &Loader[T]{}
+}
+
+// JSON 注册 JSON 格式的配置
+func (l *Loader[T]) JSON(data []byte) *Loader[T] {
+	l.files = append(l.files, func(t *T) error {
+		return loadJSON(t, "json", data)
+	})
+	return l
+}
+
+// YAML 注册 YAML 格式的配置
+func (l *Loader[T]) YAML(data []byte) *Loader[T] {
+	l.files = append(l.files, func(t *T) error {
+		return loadYAML(t, "yaml", data)
+	})
+	return l
+}
+
+// File 注册配置文件，根据扩展名选择格式：.json、.yaml、.yml
+// 文件在加载时才会被读取，文件不存在时返回错误
+func (l *Loader[T]) File(path string) *Loader[T] {
+	l.files = append(l.files, func(t *T) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".json":
+			return loadJSON(t, path, data)
+		case ".yaml", ".yml":
+			return loadYAML(t, path, data)
+		default:
+			return errs.NewErrUnsupportedFormat(path)
+		}
+	})
+	return l
+}
+
+// Env 从环境变量中加载，变量名为 prefix 加上大写的配置项名称，嵌套的 . 替换为 _
+// 例如 prefix 为 "APP_" 时，配置项 "db.max_conns" 对应 APP_DB_MAX_CONNS
+// 切片使用逗号分隔，例如 APP_HOSTS=a,b,c
+func (l *Loader[T]) Env(prefix string) *Loader[T] {
+	l.env = true
+	l.prefix = prefix
+	return l
+}
+
+// Option 将 Loader 转换为 OptionErr，通常作为 Builder 的第一个 option，之后的 option 会覆盖加载的值
+//
+//	builder.Build(loader.Option(), WithXxx(...))
+func (l *Loader[T]) Option() OptionErr[T] {
+	return func(t *T) error {
+		return l.Load(t)
+	}
+}
+
+// Load 按照优先级加载所有配置源，遇到错误立即返回
+func (l *Loader[T]) Load(t *T) error {
+	if err := ApplyErr(t, l.files...); err != nil {
+		return err
+	}
+	if l.env {
+		return loadEnv(t, l.prefix)
+	}
+	return nil
+}
+
+// EnvName 返回配置项对应的环境变量名称
+func EnvName(prefix, key string) string {
+	return prefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+func loadJSON[T any](t *T, source string, data []byte) error {
+	var values map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// 保留数字的原始文本，避免大整数丢失精度
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return errs.NewErrOptionParse(source, err)
+	}
+	return setValues(t, source, values)
+}
+
+func loadYAML[T any](t *T, source string, data []byte) error {
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return errs.NewErrOptionParse(source, err)
+	}
+	return setValues(t, source, values)
+}
+
+func loadEnv[T any](t *T, prefix string) error {
+	v := reflect.ValueOf(t).Elem()
+	fields, err := tagFields(v.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		name := EnvName(prefix, f.key)
+		val, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setField(v, f, val); err != nil {
+			return errs.NewErrOptionLoad("env "+name, f.key, err)
+		}
+	}
+	return nil
+}
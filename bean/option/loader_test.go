@@ -0,0 +1,177 @@
+/**
+ * Description：
+ * FileName：loader_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 15:21:46
+ * Remark：
+ */
+
+package option
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoader(t *testing.T) {
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "app.json")
+	yamlFile := filepath.Join(dir, "app.yml")
+	tomlFile := filepath.Join(dir, "app.toml")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"max_retries": 2, "hosts": ["a"]}`), 0o600))
+	require.NoError(t, os.WriteFile(yamlFile, []byte("max_retries: 4\ndb:\n  dsn: yaml\n"), 0o600))
+	require.NoError(t, os.WriteFile(tomlFile, []byte(`max_retries = 1`), 0o600))
+
+	testCases := []struct {
+		name    string
+		env     map[string]string
+		loader  *Loader[appConfig]
+		want    appConfig
+		wantErr error
+	}{
+		{
+			name:   "json",
+			loader: NewLoader[appConfig]().JSON([]byte(`{"max_retries": 3, "timeout": "2s", "ports": [80, 443], "db": {"max_conns": 9007199254740993}}`)),
+			want: appConfig{
+				MaxRetries: 3,
+				Timeout:    2 * time.Second,
+				Ports:      []uint16{80, 443},
+				DB:         dbConfig{MaxConns: 9007199254740993},
+			},
+		},
+		{
+			name:   "yaml",
+			loader: NewLoader[appConfig]().YAML([]byte("debug: true\nratio: 0.5\nlabels:\n  env: prod\nhosts:\n  - a\n  - b\n")),
+			want: appConfig{
+				Debug:  true,
+				Ratio:  0.5,
+				Labels: map[string]string{"env": "prod"},
+				Hosts:  []string{"a", "b"},
+			},
+		},
+		{
+			name:   "files in order",
+			loader: NewLoader[appConfig]().File(jsonFile).File(yamlFile),
+			want: appConfig{
+				MaxRetries: 4,
+				Hosts:      []string{"a"},
+				DB:         dbConfig{DSN: "yaml"},
+			},
+		},
+		{
+			name: "env overrides files",
+			env: map[string]string{
+				"APP_MAX_RETRIES": "8",
+				"APP_DB_DSN":      "env",
+				"APP_HOSTS":       "x,y",
+			},
+			loader: NewLoader[appConfig]().Env("APP_").File(yamlFile),
+			want: appConfig{
+				MaxRetries: 8,
+				Hosts:      []string{"x", "y"},
+				DB:         dbConfig{DSN: "env"},
+			},
+		},
+		{
+			name:   "float32 from json",
+			loader: NewLoader[appConfig]().JSON([]byte(`{"scale": 0.1}`)),
+			want:   appConfig{Scale: 0.1},
+		},
+		{
+			name:   "float32 from yaml",
+			loader: NewLoader[appConfig]().YAML([]byte("scale: 0.1")),
+			want:   appConfig{Scale: 0.1},
+		},
+		{
+			name:   "float32 from env",
+			env:    map[string]string{"APP_SCALE": "0.1"},
+			loader: NewLoader[appConfig]().Env("APP_"),
+			want:   appConfig{Scale: 0.1},
+		},
+		{
+			name:    "invalid json",
+			loader:  NewLoader[appConfig]().JSON([]byte(`{`)),
+			wantErr: errs.NewErrOptionParse("json", errors.New("unexpected EOF")),
+		},
+		{
+			name:    "invalid value",
+			loader:  NewLoader[appConfig]().YAML([]byte("debug: maybe")),
+			wantErr: errs.NewErrOptionLoad("yaml", "debug", errs.NewErrInvalidType("bool", "maybe")),
+		},
+		{
+			name:    "invalid env",
+			env:     map[string]string{"APP_DB_MAX_CONNS": "many"},
+			loader:  NewLoader[appConfig]().Env("APP_"),
+			wantErr: errs.NewErrOptionLoad("env APP_DB_MAX_CONNS", "db.max_conns", errs.NewErrInvalidType("int", "many")),
+		},
+		{
+			name:    "unsupported format",
+			loader:  NewLoader[appConfig]().File(tomlFile),
+			wantErr: errs.NewErrUnsupportedFormat(tomlFile),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			var cfg appConfig
+			err := tc.loader.Load(&cfg)
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+
+	// 文件不存在
+	err := NewLoader[appConfig]().File(filepath.Join(dir, "missing.json")).Load(&appConfig{})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoaderPrecedence(t *testing.T) {
+	t.Setenv("APP_RATIO", "0.75")
+	loader := NewLoader[appConfig]().
+		Env("APP_").
+		JSON([]byte(`{"max_retries": 2, "ratio": 0.1, "debug": true}`)).
+		YAML([]byte("max_retries: 3"))
+	b := NewBuilder[appConfig]().
+		Default(func(c *appConfig) {
+			c.MaxRetries = 1
+			c.Timeout = time.Second
+			c.Hosts = []string{"default"}
+		}).
+		Validate(Range("max_retries", func(c *appConfig) int { return c.MaxRetries }, 1, 10))
+
+	cfg, err := b.Build(loader.Option(), WithTag[appConfig]("hosts", "user"))
+	require.NoError(t, err)
+	assert.Equal(t, &appConfig{
+		// 默认值
+		Timeout: time.Second,
+		// YAML 覆盖 JSON
+		MaxRetries: 3,
+		// JSON
+		Debug: true,
+		// 环境变量覆盖 JSON
+		Ratio: 0.75,
+		// 用户的 option 覆盖默认值
+		Hosts: []string{"user"},
+	}, cfg)
+
+	t.Setenv("APP_MAX_RETRIES", "100")
+	_, err = b.Build(loader.Option())
+	assert.Equal(t, errors.Join(errs.NewErrOptionOutOfRange("max_retries", 100, 1, 10)), err)
+}
+
+func TestEnvName(t *testing.T) {
+	assert.Equal(t, "APP_DB_MAX_CONNS", EnvName("APP_", "db.max_conns"))
+	assert.Equal(t, "MAX_RETRIES", EnvName("", "max_retries"))
+}
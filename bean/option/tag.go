@@ -0,0 +1,283 @@
+/**
+ * Description：
+ * FileName：tag.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 13:42:09
+ * Remark：
+ */
+
+package option
+
+import (
+	"encoding"
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/internal/reflectutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TagName 用于声明配置项名称的结构体标签，例如 `echo:"max_retries"`
+// 没有该标签、标签为 "-" 或者未导出的字段不会被设置
+const TagName = "echo"
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// tagField 一个带有标签的字段，嵌套结构体的字段使用 . 连接，例如 "db.max_conns"
+type tagField struct {
+	key   string
+	path  []string
+	index []int
+	typ   reflect.Type
+}
+
+var tagFieldsCache sync.Map
+
+// tagFields 解析 T 中所有带有标签的导出字段，结果会被缓存
+// T 不是结构体时返回 errs.NewErrInvalidType
+func tagFields(typ reflect.Type) ([]tagField, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, errs.NewErrInvalidType("struct", typ.String())
+	}
+	if v, ok := tagFieldsCache.Load(typ); ok {
+		return v.([]tagField), nil
+	}
+	fields := collectTagFields(typ, nil, nil)
+	v, _ := tagFieldsCache.LoadOrStore(typ, fields)
+	return v.([]tagField), nil
+}
+
+func collectTagFields(typ reflect.Type, path []string, index []int) []tagField {
+	var res []tagField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name, ok := f.Tag.Lookup(TagName)
+		if !ok || name == "" || name == "-" || !f.IsExported() {
+			continue
+		}
+		fieldPath := append(path[:len(path):len(path)], name)
+		fieldIndex := append(index[:len(index):len(index)], i)
+		if isNestedStruct(f.Type) {
+			res = append(res, collectTagFields(f.Type, fieldPath, fieldIndex)...)
+			continue
+		}
+		res = append(res, tagField{
+			key:   strings.Join(fieldPath, "."),
+			path:  fieldPath,
+			index: fieldIndex,
+			typ:   f.Type,
+		})
+	}
+	return res
+}
+
+// isNestedStruct 是否需要展开为嵌套的配置项
+// time.Time 等实现了 encoding.TextUnmarshaler 的结构体被视为单个值
+func isNestedStruct(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && !reflect.PointerTo(typ).Implements(textUnmarshalerType)
+}
+
+// WithTag 设置标签为 key 的字段，嵌套结构体使用 . 连接，例如 "db.max_conns"
+// value 会被转换为字段的类型，例如字符串 "3" 可以设置 int 字段，"1s" 可以设置 time.Duration 字段
+// 找不到 key 对应的导出字段时返回 errs.NewErrUnknownOption，T 不是结构体时返回 errs.NewErrInvalidType
+func WithTag[T any](key string, value any) OptionErr[T] {
+	return func(t *T) error {
+		fields, err := tagFields(reflect.TypeOf(t).Elem())
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			if f.key == key {
+				return setField(reflect.ValueOf(t).Elem(), f, value)
+			}
+		}
+		return errs.NewErrUnknownOption(key)
+	}
+}
+
+// FromValues 使用 values 设置带有标签的字段，嵌套结构体对应嵌套的 map
+// 例如 {"max_retries": 3, "db": {"max_conns": 10}}，values 中多余的键会被忽略
+func FromValues[T any](values map[string]any) OptionErr[T] {
+	return func(t *T) error {
+		return setValues(t, "values", values)
+	}
+}
+
+func setValues[T any](t *T, source string, values map[string]any) error {
+	v := reflect.ValueOf(t).Elem()
+	fields, err := tagFields(v.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		val, ok := lookupPath(values, f.path)
+		if !ok {
+			continue
+		}
+		if err := setField(v, f, val); err != nil {
+			return errs.NewErrOptionLoad(source, f.key, err)
+		}
+	}
+	return nil
+}
+
+func lookupPath(values map[string]any, path []string) (any, bool) {
+	var cur any = values
+	for _, key := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func setField(v reflect.Value, f tagField, value any) error {
+	fv := v.FieldByIndex(f.index)
+	res, err := convert(value, f.typ)
+	if err != nil {
+		return err
+	}
+	fv.Set(res)
+	return nil
+}
+
+// convert 将配置源中的值转换为 typ 类型
+// 支持字符串解析、JSON 数字、数组以及 map，类型不匹配时返回 errs.NewErrInvalidType
+func convert(value any, typ reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(typ), nil
+	}
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(typ) {
+		res := reflect.New(typ).Elem()
+		res.Set(src)
+		return res, nil
+	}
+	if typ.Kind() == reflect.Pointer {
+		elem, err := convert(value, typ.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		res := reflect.New(typ.Elem())
+		res.Elem().Set(elem)
+		return res, nil
+	}
+	if s, ok := value.(json.Number); ok {
+		value = string(s)
+	}
+	if s, ok := value.(string); ok {
+		return parseString(s, typ)
+	}
+	invalid := errs.NewErrInvalidType(typ.String(), value)
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		// time.Duration 只能通过字符串设置，例如 "1s"，避免数字的单位产生歧义
+		if typ == durationType {
+			return reflect.Value{}, invalid
+		}
+		res, ok := reflectutil.ConvertNumber(src, typ)
+		if !ok {
+			return reflect.Value{}, invalid
+		}
+		return res, nil
+	case reflect.Slice:
+		if src.Kind() != reflect.Slice {
+			return reflect.Value{}, invalid
+		}
+		res := reflect.MakeSlice(typ, src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			elem, err := convert(src.Index(i).Interface(), typ.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			res.Index(i).Set(elem)
+		}
+		return res, nil
+	case reflect.Map:
+		if src.Kind() != reflect.Map {
+			return reflect.Value{}, invalid
+		}
+		res := reflect.MakeMapWithSize(typ, src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			k, err := convert(iter.Key().Interface(), typ.Key())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			val, err := convert(iter.Value().Interface(), typ.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			res.SetMapIndex(k, val)
+		}
+		return res, nil
+	default:
+		return reflect.Value{}, invalid
+	}
+}
+
+// parseString 将字符串解析为 typ 类型，切片使用逗号分隔
+func parseString(s string, typ reflect.Type) (reflect.Value, error) {
+	res := reflect.New(typ).Elem()
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		if err := res.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+			return reflect.Value{}, errs.NewErrInvalidType(typ.String(), s)
+		}
+		return res, nil
+	}
+	var err error
+	switch typ.Kind() {
+	case reflect.String:
+		res.SetString(s)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		res.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if typ == durationType {
+			var d time.Duration
+			d, err = time.ParseDuration(s)
+			i = int64(d)
+		} else {
+			i, err = strconv.ParseInt(s, 10, typ.Bits())
+		}
+		res.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, typ.Bits())
+		res.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(s, typ.Bits())
+		res.SetFloat(f)
+	case reflect.Slice:
+		if s == "" {
+			return reflect.MakeSlice(typ, 0, 0), nil
+		}
+		parts := strings.Split(s, ",")
+		items := make([]any, len(parts))
+		for i, p := range parts {
+			items[i] = strings.TrimSpace(p)
+		}
+		return convert(items, typ)
+	default:
+		return reflect.Value{}, errs.NewErrInvalidType(typ.String(), s)
+	}
+	if err != nil {
+		return reflect.Value{}, errs.NewErrInvalidType(typ.String(), s)
+	}
+	return res, nil
+}
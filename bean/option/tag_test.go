@@ -0,0 +1,255 @@
+/**
+ * Description：
+ * FileName：tag_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 14:58:20
+ * Remark：
+ */
+
+package option
+
+import (
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type dbConfig struct {
+	MaxConns int    `echo:"max_conns"`
+	DSN      string `echo:"dsn"`
+}
+
+type appConfig struct {
+	MaxRetries int               `echo:"max_retries"`
+	Timeout    time.Duration     `echo:"timeout"`
+	Ratio      float64           `echo:"ratio"`
+	Scale      float32           `echo:"scale"`
+	Debug      bool              `echo:"debug"`
+	Hosts      []string          `echo:"hosts"`
+	Ports      []uint16          `echo:"ports"`
+	Labels     map[string]string `echo:"labels"`
+	Start      time.Time         `echo:"start"`
+	Limit      *int              `echo:"limit"`
+	DB         dbConfig          `echo:"db"`
+	Ignored    string            `echo:"-"`
+	NoTag      string
+	secret     string `echo:"secret"`
+}
+
+func TestTagFields(t *testing.T) {
+	var keys []string
+	fields, err := tagFields(reflect.TypeOf(appConfig{}))
+	require.NoError(t, err)
+	for _, f := range fields {
+		keys = append(keys, f.key)
+	}
+	assert.Equal(t, []string{
+		"max_retries", "timeout", "ratio", "scale", "debug", "hosts", "ports",
+		"labels", "start", "limit", "db.max_conns", "db.dsn",
+	}, keys)
+}
+
+func TestWithTag(t *testing.T) {
+	limit := 5
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		name    string
+		key     string
+		value   any
+		want    appConfig
+		wantErr error
+	}{
+		{
+			name:  "int",
+			key:   "max_retries",
+			value: 3,
+			want:  appConfig{MaxRetries: 3},
+		},
+		{
+			name:  "int from string",
+			key:   "max_retries",
+			value: "7",
+			want:  appConfig{MaxRetries: 7},
+		},
+		{
+			name:  "int from integral float",
+			key:   "max_retries",
+			value: 2.0,
+			want:  appConfig{MaxRetries: 2},
+		},
+		{
+			name:  "int from json number",
+			key:   "max_retries",
+			value: json.Number("9"),
+			want:  appConfig{MaxRetries: 9},
+		},
+		{
+			name:  "duration",
+			key:   "timeout",
+			value: "1m30s",
+			want:  appConfig{Timeout: 90 * time.Second},
+		},
+		{
+			name:  "float from int",
+			key:   "ratio",
+			value: 1,
+			want:  appConfig{Ratio: 1},
+		},
+		{
+			name:  "float32 from float64",
+			key:   "scale",
+			value: 0.1,
+			want:  appConfig{Scale: 0.1},
+		},
+		{
+			name:    "float32 out of range",
+			key:     "scale",
+			value:   1e39,
+			wantErr: errs.NewErrInvalidType("float32", 1e39),
+		},
+		{
+			name:  "bool from string",
+			key:   "debug",
+			value: "true",
+			want:  appConfig{Debug: true},
+		},
+		{
+			name:  "slice from comma separated string",
+			key:   "hosts",
+			value: "a, b,c",
+			want:  appConfig{Hosts: []string{"a", "b", "c"}},
+		},
+		{
+			name:  "slice from any slice",
+			key:   "ports",
+			value: []any{80, "443"},
+			want:  appConfig{Ports: []uint16{80, 443}},
+		},
+		{
+			name:  "map",
+			key:   "labels",
+			value: map[string]any{"env": "prod"},
+			want:  appConfig{Labels: map[string]string{"env": "prod"}},
+		},
+		{
+			name:  "text unmarshaler",
+			key:   "start",
+			value: "2026-01-02T03:04:05Z",
+			want:  appConfig{Start: start},
+		},
+		{
+			name:  "pointer",
+			key:   "limit",
+			value: "5",
+			want:  appConfig{Limit: &limit},
+		},
+		{
+			name:  "nested",
+			key:   "db.max_conns",
+			value: 10,
+			want:  appConfig{DB: dbConfig{MaxConns: 10}},
+		},
+		{
+			name:    "unknown key",
+			key:     "no_tag",
+			value:   "x",
+			wantErr: errs.NewErrUnknownOption("no_tag"),
+		},
+		{
+			name:    "unexported field",
+			key:     "secret",
+			value:   "x",
+			wantErr: errs.NewErrUnknownOption("secret"),
+		},
+		{
+			name:    "ignored key",
+			key:     "-",
+			value:   "x",
+			wantErr: errs.NewErrUnknownOption("-"),
+		},
+		{
+			name:    "invalid string",
+			key:     "max_retries",
+			value:   "abc",
+			wantErr: errs.NewErrInvalidType("int", "abc"),
+		},
+		{
+			name:    "truncated float",
+			key:     "max_retries",
+			value:   1.5,
+			wantErr: errs.NewErrInvalidType("int", 1.5),
+		},
+		{
+			name:    "negative to unsigned",
+			key:     "ports",
+			value:   []any{-1},
+			wantErr: errs.NewErrInvalidType("uint16", -1),
+		},
+		{
+			name:    "overflow",
+			key:     "ports",
+			value:   []any{70000},
+			wantErr: errs.NewErrInvalidType("uint16", 70000),
+		},
+		{
+			name:    "duration from number",
+			key:     "timeout",
+			value:   5,
+			wantErr: errs.NewErrInvalidType("time.Duration", 5),
+		},
+		{
+			name:    "wrong kind",
+			key:     "hosts",
+			value:   true,
+			wantErr: errs.NewErrInvalidType("[]string", true),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg appConfig
+			err := WithTag[appConfig](tc.key, tc.value)(&cfg)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+}
+
+func TestFromValues(t *testing.T) {
+	cfg := appConfig{Ratio: 0.5, Ignored: "keep", NoTag: "keep"}
+	err := FromValues[appConfig](map[string]any{
+		"max_retries": 3,
+		"debug":       true,
+		"db":          map[string]any{"dsn": "mysql://"},
+		"unknown":     "ignored",
+		"Ignored":     "changed",
+		"NoTag":       "changed",
+		"secret":      "changed",
+	})(&cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, appConfig{
+		MaxRetries: 3,
+		Ratio:      0.5,
+		Debug:      true,
+		DB:         dbConfig{DSN: "mysql://"},
+		Ignored:    "keep",
+		NoTag:      "keep",
+	}, cfg)
+
+	err = FromValues[appConfig](map[string]any{"db": map[string]any{"max_conns": "x"}})(&cfg)
+	assert.Equal(t, errs.NewErrOptionLoad("values", "db.max_conns", errs.NewErrInvalidType("int", "x")), err)
+}
+
+func TestTagNotStruct(t *testing.T) {
+	var n int
+	assert.Equal(t, errs.NewErrInvalidType("struct", "int"), WithTag[int]("a", 1)(&n))
+	assert.Equal(t, errs.NewErrInvalidType("struct", "int"), FromValues[int](map[string]any{"a": 1})(&n))
+	assert.Equal(t, errs.NewErrInvalidType("struct", "int"), NewLoader[int]().YAML([]byte("a: 1")).Load(&n))
+	assert.Equal(t, errs.NewErrInvalidType("struct", "int"), NewLoader[int]().Env("APP_").Load(&n))
+}
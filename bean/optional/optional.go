@@ -0,0 +1,144 @@
+/**
+ * Description：
+ * FileName：optional.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 10:52:13
+ * Remark：
+ */
+
+package optional
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+var nullJSON = []byte("null")
+
+// Optional 可能存在也可能不存在的值，零值表示不存在
+// JSON 序列化时不存在为 null，存在时为值本身
+// 实现了 sql.Scanner 和 driver.Valuer，可以替代 sql.NullXxx 表示可以为 NULL 的列
+type Optional[T any] struct {
+	val     T
+	present bool
+}
+
+// Some 创建一个存在值的 Optional
+func Some[T any](val T) Optional[T] {
+	return Optional[T]{
+		val:     val,
+		present: true,
+	}
+}
+
+// None 创建一个不存在值的 Optional
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// FromPtr 指针为 nil 时返回 None，否则返回指针指向的值
+func FromPtr[T any](ptr *T) Optional[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+	return Some(*ptr)
+}
+
+// IsPresent 值是否存在
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get 返回值以及值是否存在，不存在时返回 T 的零值
+func (o Optional[T]) Get() (T, bool) {
+	return o.val, o.present
+}
+
+// OrElse 值存在时返回值，否则返回 other
+func (o Optional[T]) OrElse(other T) T {
+	if o.present {
+		return o.val
+	}
+	return other
+}
+
+// OrElseGet 值存在时返回值，否则返回 fn 的结果，fn 只会在值不存在时调用
+func (o Optional[T]) OrElseGet(fn func() T) T {
+	if o.present {
+		return o.val
+	}
+	return fn()
+}
+
+// Ptr 值存在时返回指向值副本的指针，否则返回 nil
+func (o Optional[T]) Ptr() *T {
+	if !o.present {
+		return nil
+	}
+	val := o.val
+	return &val
+}
+
+func (o Optional[T]) String() string {
+	if !o.present {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.val)
+}
+
+// Map 值存在时使用 fn 转换，否则返回 None
+func Map[T any, R any](o Optional[T], fn func(T) R) Optional[R] {
+	if !o.present {
+		return None[R]()
+	}
+	return Some(fn(o.val))
+}
+
+// FlatMap 值存在时返回 fn 的结果，否则返回 None
+func FlatMap[T any, R any](o Optional[T], fn func(T) Optional[R]) Optional[R] {
+	if !o.present {
+		return None[R]()
+	}
+	return fn(o.val)
+}
+
+// MarshalJSON 不存在时输出 null，否则输出值本身
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return nullJSON, nil
+	}
+	return json.Marshal(o.val)
+}
+
+// UnmarshalJSON null 反序列化为 None，否则反序列化为值
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), nullJSON) {
+		*o = None[T]()
+		return nil
+	}
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*o = Some(val)
+	return nil
+}
+
+// Scan 实现 sql.Scanner，NULL 扫描为 None
+// 类型转换规则与 sql.Null 一致
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	o.val, o.present = n.V, n.Valid
+	return nil
+}
+
+// Value 实现 driver.Valuer，None 写入 NULL
+func (o Optional[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.val, Valid: o.present}.Value()
+}
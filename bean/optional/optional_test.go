@@ -0,0 +1,282 @@
+/**
+ * Description：
+ * FileName：optional_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 11:13:40
+ * Remark：
+ */
+
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestOptional(t *testing.T) {
+	testCases := []struct {
+		name        string
+		o           Optional[int]
+		wantPresent bool
+		wantVal     int
+		wantOrElse  int
+		wantString  string
+	}{
+		{
+			name:        "some",
+			o:           Some(1),
+			wantPresent: true,
+			wantVal:     1,
+			wantOrElse:  1,
+			wantString:  "Some(1)",
+		},
+		{
+			name:        "some zero value",
+			o:           Some(0),
+			wantPresent: true,
+			wantVal:     0,
+			wantOrElse:  0,
+			wantString:  "Some(0)",
+		},
+		{
+			name:       "none",
+			o:          None[int](),
+			wantOrElse: -1,
+			wantString: "None",
+		},
+		{
+			name:       "zero value is none",
+			o:          Optional[int]{},
+			wantOrElse: -1,
+			wantString: "None",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantPresent, tc.o.IsPresent())
+			val, ok := tc.o.Get()
+			assert.Equal(t, tc.wantPresent, ok)
+			assert.Equal(t, tc.wantVal, val)
+			assert.Equal(t, tc.wantOrElse, tc.o.OrElse(-1))
+			assert.Equal(t, tc.wantOrElse, tc.o.OrElseGet(func() int { return -1 }))
+			assert.Equal(t, tc.wantString, tc.o.String())
+			if tc.wantPresent {
+				assert.Equal(t, tc.wantVal, *tc.o.Ptr())
+			} else {
+				assert.Nil(t, tc.o.Ptr())
+			}
+		})
+	}
+}
+
+func TestOrElseGetLazy(t *testing.T) {
+	called := false
+	assert.Equal(t, 1, Some(1).OrElseGet(func() int {
+		called = true
+		return 2
+	}))
+	assert.False(t, called)
+}
+
+func TestFromPtr(t *testing.T) {
+	val := 3
+	assert.Equal(t, Some(3), FromPtr(&val))
+	assert.Equal(t, None[int](), FromPtr[int](nil))
+}
+
+func TestMapAndFlatMap(t *testing.T) {
+	toStr := func(v int) string { return strconv.Itoa(v) }
+	assert.Equal(t, Some("1"), Map(Some(1), toStr))
+	assert.Equal(t, None[string](), Map(None[int](), toStr))
+
+	parse := func(s string) Optional[int] {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return None[int]()
+		}
+		return Some(v)
+	}
+	assert.Equal(t, Some(12), FlatMap(Some("12"), parse))
+	assert.Equal(t, None[int](), FlatMap(Some("abc"), parse))
+	assert.Equal(t, None[int](), FlatMap(None[string](), parse))
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type user struct {
+		Name Optional[string] `json:"name"`
+		Age  Optional[int]    `json:"age"`
+	}
+	testCases := []struct {
+		name    string
+		u       user
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "all present",
+			u:    user{Name: Some("Tom"), Age: Some(0)},
+			want: `{"name":"Tom","age":0}`,
+		},
+		{
+			name: "none as null",
+			u:    user{Name: Some("Tom")},
+			want: `{"name":"Tom","age":null}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.u)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(data))
+
+			var res user
+			require.NoError(t, json.Unmarshal(data, &res))
+			assert.Equal(t, tc.u, res)
+		})
+	}
+
+	// 缺失的字段保持 None
+	var res user
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Tom"}`), &res))
+	assert.Equal(t, user{Name: Some("Tom")}, res)
+
+	// 类型不匹配
+	assert.Error(t, json.Unmarshal([]byte(`{"age":"abc"}`), &res))
+}
+
+func TestOptionalScan(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name    string
+		src     any
+		scan    func(src any) (any, error)
+		want    any
+		wantErr bool
+	}{
+		{
+			name: "null",
+			src:  nil,
+			scan: func(src any) (any, error) {
+				var o Optional[int64]
+				o.val = 1
+				o.present = true
+				err := o.Scan(src)
+				return o, err
+			},
+			want: None[int64](),
+		},
+		{
+			name: "int64",
+			src:  int64(10),
+			scan: func(src any) (any, error) {
+				var o Optional[int64]
+				err := o.Scan(src)
+				return o, err
+			},
+			want: Some(int64(10)),
+		},
+		{
+			name: "bytes to string",
+			src:  []byte("hello"),
+			scan: func(src any) (any, error) {
+				var o Optional[string]
+				err := o.Scan(src)
+				return o, err
+			},
+			want: Some("hello"),
+		},
+		{
+			name: "string to int",
+			src:  "42",
+			scan: func(src any) (any, error) {
+				var o Optional[int]
+				err := o.Scan(src)
+				return o, err
+			},
+			want: Some(42),
+		},
+		{
+			name: "time",
+			src:  now,
+			scan: func(src any) (any, error) {
+				var o Optional[time.Time]
+				err := o.Scan(src)
+				return o, err
+			},
+			want: Some(now),
+		},
+		{
+			name: "nested scanner",
+			src:  "abc",
+			scan: func(src any) (any, error) {
+				var o Optional[sql.NullString]
+				err := o.Scan(src)
+				return o, err
+			},
+			want: Some(sql.NullString{String: "abc", Valid: true}),
+		},
+		{
+			name: "invalid",
+			src:  "abc",
+			scan: func(src any) (any, error) {
+				var o Optional[int]
+				err := o.Scan(src)
+				return o, err
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.scan(tc.src)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestOptionalValue(t *testing.T) {
+	testCases := []struct {
+		name  string
+		valer driver.Valuer
+		want  driver.Value
+	}{
+		{
+			name:  "none",
+			valer: None[string](),
+			want:  nil,
+		},
+		{
+			name:  "string",
+			valer: Some("abc"),
+			want:  "abc",
+		},
+		{
+			name:  "int32 converted to int64",
+			valer: Some(int32(5)),
+			want:  int64(5),
+		},
+		{
+			name:  "nested valuer",
+			valer: Some(sql.NullInt64{Int64: 7, Valid: true}),
+			want:  int64(7),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := tc.valer.Value()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, val)
+		})
+	}
+}
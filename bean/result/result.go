@@ -0,0 +1,107 @@
+/**
+ * Description：
+ * FileName：result.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 11:46:25
+ * Remark：
+ */
+
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Result 值或者错误，err 不为 nil 时表示失败
+// 适用于在管道中传递可能失败的计算结果
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// Ok 创建一个成功的 Result
+func Ok[T any](val T) Result[T] {
+	return Result[T]{val: val}
+}
+
+// Err 创建一个失败的 Result
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Of 将 (T, error) 形式的返回值包装为 Result，例如 result.Of(strconv.Atoi(s))
+// err 不为 nil 时忽略 val
+func Of[T any](val T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(val)
+}
+
+// IsOk 是否成功
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Get 返回值和错误
+func (r Result[T]) Get() (T, error) {
+	return r.val, r.err
+}
+
+// Err 返回错误，成功时为 nil
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap 返回值，失败时 panic
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("echo: 对失败的 Result 调用 Unwrap: %v", r.err))
+	}
+	return r.val
+}
+
+// UnwrapOr 成功时返回值，否则返回 other
+func (r Result[T]) UnwrapOr(other T) T {
+	if r.err != nil {
+		return other
+	}
+	return r.val
+}
+
+// Map 成功时使用 fn 转换值，失败时原样传递错误
+func Map[T any, R any](r Result[T], fn func(T) R) Result[R] {
+	if r.err != nil {
+		return Err[R](r.err)
+	}
+	return Ok(fn(r.val))
+}
+
+// AndThen 成功时返回 fn 的结果，失败时原样传递错误，用于串联多个可能失败的步骤
+func AndThen[T any, R any](r Result[T], fn func(T) Result[R]) Result[R] {
+	if r.err != nil {
+		return Err[R](r.err)
+	}
+	return fn(r.val)
+}
+
+// Collect 所有 Result 都成功时按顺序返回所有值
+// 否则返回 nil 以及使用 errors.Join 按顺序合并的所有错误
+// 即使输入为 nil，也保证成功时返回非 nil 的空切片
+func Collect[T any](results []Result[T]) ([]T, error) {
+	var errList []error
+	for _, r := range results {
+		if r.err != nil {
+			errList = append(errList, r.err)
+		}
+	}
+	if len(errList) > 0 {
+		return nil, errors.Join(errList...)
+	}
+	res := make([]T, len(results))
+	for i, r := range results {
+		res[i] = r.val
+	}
+	return res, nil
+}
@@ -0,0 +1,127 @@
+/**
+ * Description：
+ * FileName：result_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 12:10:58
+ * Remark：
+ */
+
+package result
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	errBoom := errors.New("boom")
+	testCases := []struct {
+		name       string
+		r          Result[int]
+		wantOk     bool
+		wantVal    int
+		wantErr    error
+		wantOr     int
+		wantPanics bool
+	}{
+		{
+			name:    "ok",
+			r:       Ok(1),
+			wantOk:  true,
+			wantVal: 1,
+			wantOr:  1,
+		},
+		{
+			name:       "err",
+			r:          Err[int](errBoom),
+			wantErr:    errBoom,
+			wantOr:     -1,
+			wantPanics: true,
+		},
+		{
+			name:    "of ok",
+			r:       Of(strconv.Atoi("12")),
+			wantOk:  true,
+			wantVal: 12,
+			wantOr:  12,
+		},
+		{
+			name:       "of err ignores value",
+			r:          Of(3, errBoom),
+			wantErr:    errBoom,
+			wantOr:     -1,
+			wantPanics: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantOk, tc.r.IsOk())
+			assert.Equal(t, tc.wantErr, tc.r.Err())
+			val, err := tc.r.Get()
+			assert.Equal(t, tc.wantVal, val)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOr, tc.r.UnwrapOr(-1))
+			if tc.wantPanics {
+				assert.Panics(t, func() { tc.r.Unwrap() })
+			} else {
+				assert.Equal(t, tc.wantVal, tc.r.Unwrap())
+			}
+		})
+	}
+}
+
+func TestMapAndThen(t *testing.T) {
+	errBoom := errors.New("boom")
+	double := func(v int) int { return v * 2 }
+	assert.Equal(t, Ok(4), Map(Ok(2), double))
+	assert.Equal(t, Err[int](errBoom), Map(Err[int](errBoom), double))
+
+	parse := func(s string) Result[int] { return Of(strconv.Atoi(s)) }
+	assert.Equal(t, Ok(12), AndThen(Ok("12"), parse))
+	assert.Equal(t, Err[int](errBoom), AndThen(Err[string](errBoom), parse))
+	assert.Error(t, AndThen(Ok("abc"), parse).Err())
+
+	// 串联多个步骤
+	res := Map(AndThen(Ok("21"), parse), double)
+	assert.Equal(t, 42, res.Unwrap())
+}
+
+func TestCollect(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	testCases := []struct {
+		name    string
+		results []Result[int]
+		want    []int
+		wantErr error
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name:    "all ok",
+			results: []Result[int]{Ok(1), Ok(2)},
+			want:    []int{1, 2},
+		},
+		{
+			name:    "one error",
+			results: []Result[int]{Ok(1), Err[int](err1)},
+			wantErr: errors.Join(err1),
+		},
+		{
+			name:    "all errors",
+			results: []Result[int]{Err[int](err1), Ok(2), Err[int](err2)},
+			wantErr: errors.Join(err1, err2),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Collect(tc.results)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
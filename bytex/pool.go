@@ -0,0 +1,125 @@
+/**
+ * Description：
+ * FileName：pool.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 23:31:09
+ * Remark：
+ */
+
+package bytex
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"math/bits"
+	"sync"
+)
+
+const (
+	defaultMinSize = 64
+	defaultMaxSize = 64 << 10
+)
+
+var defaultPool = NewBufferPool()
+
+// BufferPool 按容量分级的字节切片池
+// 每个级别的容量是 2 的幂，从 minSize 到 maxSize，每个级别对应一个 sync.Pool
+// 超过 maxSize 的切片不会被缓存，避免长期持有过大的内存
+type BufferPool struct {
+	minSize int
+	maxSize int
+	// minBits = log2(minSize)
+	minBits int
+	pools   []sync.Pool
+}
+
+// NewBufferPool 创建 BufferPool，默认容量范围为 64B ~ 64KB
+func NewBufferPool(opts ...option.Option[BufferPool]) *BufferPool {
+	p := &BufferPool{
+		minSize: defaultMinSize,
+		maxSize: defaultMaxSize,
+	}
+	option.Apply(p, opts...)
+	p.minSize = ceilPowerOfTwo(p.minSize)
+	p.maxSize = ceilPowerOfTwo(p.maxSize)
+	if p.maxSize < p.minSize {
+		p.maxSize = p.minSize
+	}
+	p.minBits = bits.Len(uint(p.minSize)) - 1
+	p.pools = make([]sync.Pool, bits.Len(uint(p.maxSize))-p.minBits)
+	return p
+}
+
+// WithMinSize 设置最小的容量级别，会向上取整为 2 的幂
+func WithMinSize(size int) option.Option[BufferPool] {
+	return func(p *BufferPool) {
+		p.minSize = size
+	}
+}
+
+// WithMaxSize 设置最大的容量级别，会向上取整为 2 的幂
+// 容量超过该值的切片不会被缓存
+func WithMaxSize(size int) option.Option[BufferPool] {
+	return func(p *BufferPool) {
+		p.maxSize = size
+	}
+}
+
+// Get 获取一个长度为 0、容量不小于 size 的切片
+// 返回指针是为了在 Put 时避免额外的内存分配
+func (p *BufferPool) Get(size int) *[]byte {
+	if size > p.maxSize {
+		b := make([]byte, 0, size)
+		return &b
+	}
+	idx := p.getIndex(size)
+	if v := p.pools[idx].Get(); v != nil {
+		b := v.(*[]byte)
+		*b = (*b)[:0]
+		return b
+	}
+	b := make([]byte, 0, p.minSize<<idx)
+	return &b
+}
+
+// Put 归还切片，归还后不能再使用该切片
+// 容量不在 [minSize, maxSize] 范围内的切片会被直接丢弃
+func (p *BufferPool) Put(b *[]byte) {
+	if b == nil {
+		return
+	}
+	c := cap(*b)
+	if c < p.minSize || c > p.maxSize {
+		return
+	}
+	p.pools[p.putIndex(c)].Put(b)
+}
+
+// getIndex 返回能够容纳 size 的最小级别
+func (p *BufferPool) getIndex(size int) int {
+	if size <= p.minSize {
+		return 0
+	}
+	return bits.Len(uint(size-1)) - p.minBits
+}
+
+// putIndex 返回不超过 c 的最大级别，保证从该级别取出的切片容量足够
+func (p *BufferPool) putIndex(c int) int {
+	return bits.Len(uint(c)) - 1 - p.minBits
+}
+
+// Get 从默认的 BufferPool 中获取切片
+func Get(size int) *[]byte {
+	return defaultPool.Get(size)
+}
+
+// Put 将切片归还到默认的 BufferPool
+func Put(b *[]byte) {
+	defaultPool.Put(b)
+}
+
+func ceilPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(n-1))
+}
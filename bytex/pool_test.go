@@ -0,0 +1,169 @@
+/**
+ * Description：
+ * FileName：pool_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 00:08:15
+ * Remark：
+ */
+
+package bytex
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewBufferPool(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pool      *BufferPool
+		wantMin   int
+		wantMax   int
+		wantPools int
+	}{
+		{
+			name:      "default",
+			pool:      NewBufferPool(),
+			wantMin:   64,
+			wantMax:   64 << 10,
+			wantPools: 11,
+		},
+		{
+			name:      "round up",
+			pool:      NewBufferPool(WithMinSize(100), WithMaxSize(1000)),
+			wantMin:   128,
+			wantMax:   1024,
+			wantPools: 4,
+		},
+		{
+			name:      "max less than min",
+			pool:      NewBufferPool(WithMinSize(256), WithMaxSize(16)),
+			wantMin:   256,
+			wantMax:   256,
+			wantPools: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantMin, tc.pool.minSize)
+			assert.Equal(t, tc.wantMax, tc.pool.maxSize)
+			assert.Equal(t, tc.wantPools, len(tc.pool.pools))
+		})
+	}
+}
+
+func TestBufferPoolGet(t *testing.T) {
+	p := NewBufferPool(WithMinSize(64), WithMaxSize(1024))
+	testCases := []struct {
+		name    string
+		size    int
+		wantCap int
+	}{
+		{
+			name:    "zero",
+			size:    0,
+			wantCap: 64,
+		},
+		{
+			name:    "min",
+			size:    64,
+			wantCap: 64,
+		},
+		{
+			name:    "round up",
+			size:    65,
+			wantCap: 128,
+		},
+		{
+			name:    "max",
+			size:    1024,
+			wantCap: 1024,
+		},
+		{
+			name:    "too large",
+			size:    1025,
+			wantCap: 1025,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := p.Get(tc.size)
+			assert.Equal(t, 0, len(*b))
+			assert.Equal(t, tc.wantCap, cap(*b))
+		})
+	}
+}
+
+func TestBufferPoolPut(t *testing.T) {
+	p := NewBufferPool(WithMinSize(64), WithMaxSize(1024))
+	testCases := []struct {
+		name      string
+		cap       int
+		wantIndex int
+	}{
+		{
+			name:      "min",
+			cap:       64,
+			wantIndex: 0,
+		},
+		{
+			name:      "exact",
+			cap:       128,
+			wantIndex: 1,
+		},
+		{
+			name:      "round down",
+			cap:       200,
+			wantIndex: 1,
+		},
+		{
+			name:      "max",
+			cap:       1024,
+			wantIndex: 4,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantIndex, p.putIndex(tc.cap))
+		})
+	}
+
+	// 超出范围的切片直接丢弃
+	small := make([]byte, 0, 32)
+	large := make([]byte, 0, 2048)
+	p.Put(&small)
+	p.Put(&large)
+	p.Put(nil)
+
+	// 归还后的切片可以被复用，且长度被重置
+	b := p.Get(100)
+	*b = append(*b, "hello"...)
+	p.Put(b)
+	for i := 0; i < 10; i++ {
+		nb := p.Get(128)
+		assert.Equal(t, 0, len(*nb))
+		assert.GreaterOrEqual(t, cap(*nb), 128)
+	}
+}
+
+func TestBufferPoolAllocs(t *testing.T) {
+	p := NewBufferPool()
+	p.Put(p.Get(512))
+	allocs := testing.AllocsPerRun(100, func() {
+		b := p.Get(512)
+		*b = append(*b, "hello"...)
+		p.Put(b)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkBufferPool(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			buf := Get(1024)
+			*buf = append(*buf, "hello world"...)
+			Put(buf)
+		}
+	})
+}
@@ -0,0 +1,676 @@
+/**
+ * Description：
+ * FileName：generator.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 19:40:16
+ * Remark：
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/carefuly/careful-echo/bean/copier"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const copierPath = "github.com/carefuly/careful-echo/bean/copier"
+
+// Generate 读取 dir 中的 Go 源码，为 pairs 中的每一对 (源类型, 目标类型) 生成复制函数
+// 生成的函数与 copier.Copier 的复制规则、错误完全一致，但不使用反射
+// exclude 为需要忽略的文件名，通常是上一次生成的文件
+func Generate(dir string, pairs []pair.Pair[string, string], exclude string) ([]byte, error) {
+	pkg, err := loadPackage(dir, exclude)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{
+		pkg:     pkg,
+		imports: map[string]string{},
+		names:   map[pairKey]string{},
+		paths:   map[pairKey]string{},
+	}
+	for _, p := range pairs {
+		src, dst, err := g.lookupPair(p)
+		if err != nil {
+			return nil, err
+		}
+		g.names[pairKey{src: src, dst: dst}] = "Copy" + src.Obj().Name() + "To" + dst.Obj().Name()
+		g.queue = append(g.queue, pairKey{src: src, dst: dst})
+	}
+	for i := 0; i < len(g.queue); i++ {
+		if err := g.genFunc(g.queue[i]); err != nil {
+			return nil, err
+		}
+	}
+	return g.output()
+}
+
+// loadPackage 解析并检查 dir 中的包，忽略测试文件以及 exclude
+// 类型检查的错误会被忽略，例如引用了尚未生成的复制函数
+func loadPackage(dir, exclude string) (*types.Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		if name == exclude {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(bp.ImportPath, fset, files, nil)
+	return pkg, nil
+}
+
+type pairKey struct {
+	src *types.Named
+	dst *types.Named
+}
+
+type generator struct {
+	pkg *types.Package
+	// imports 生成的代码中用到的包，路径 => 包名
+	imports map[string]string
+	// names 已经分配了函数名称的类型对，queue 为等待生成的类型对
+	names map[pairKey]string
+	queue []pairKey
+	// paths 类型对第一次出现时的字段路径，用于生成错误信息
+	paths map[pairKey]string
+	funcs bytes.Buffer
+}
+
+func (g *generator) lookupPair(p pair.Pair[string, string]) (*types.Named, *types.Named, error) {
+	src, err := g.lookupStruct(p.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := g.lookupStruct(p.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return src, dst, nil
+}
+
+func (g *generator) lookupStruct(name string) (*types.Named, error) {
+	obj, ok := g.pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("echo-gen: 包 %s 中没有找到类型 %s", g.pkg.Name(), name)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("echo-gen: %s 不是非泛型的结构体", name)
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, fmt.Errorf("echo-gen: %s 不是非泛型的结构体", name)
+	}
+	return named, nil
+}
+
+// funcName 返回类型对的复制函数名称，第一次引用时加入生成队列
+func (g *generator) funcName(key pairKey, path string) string {
+	if name, ok := g.names[key]; ok {
+		return name
+	}
+	name := "copy" + key.src.Obj().Name() + "To" + key.dst.Obj().Name()
+	for i := 2; g.nameUsed(name); i++ {
+		name = "copy" + key.src.Obj().Name() + "To" + key.dst.Obj().Name() + strconv.Itoa(i)
+	}
+	g.names[key] = name
+	g.paths[key] = path
+	g.queue = append(g.queue, key)
+	return name
+}
+
+func (g *generator) nameUsed(name string) bool {
+	for _, n := range g.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) genFunc(key pairKey) error {
+	w := &funcWriter{indent: 1}
+	if err := g.copyStruct(w, "dst", "src", key.dst.Underlying().(*types.Struct), key.src.Underlying().(*types.Struct), "", g.paths[key]); err != nil {
+		return err
+	}
+	name := g.names[key]
+	src, dst := g.typeString(key.src), g.typeString(key.dst)
+	if ast.IsExported(name) {
+		fmt.Fprintf(&g.funcs, "// %s 将 src 复制到 dst，dst 中没有匹配的字段保持不变\n", name)
+		fmt.Fprintf(&g.funcs, "// 与 copier.NewCopier[%s, %s]() 的 CopyTo 行为一致\n", src, dst)
+	}
+	fmt.Fprintf(&g.funcs, "func %s(dst *%s, src *%s) error {\n", name, dst, src)
+	g.funcs.Write(w.buf.Bytes())
+	g.funcs.WriteString("\treturn nil\n}\n\n")
+	return nil
+}
+
+func (g *generator) output() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by echo-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkg.Name())
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for p := range g.imports {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		buf.WriteString("import (\n")
+		for _, p := range paths {
+			fmt.Fprintf(&buf, "\t%q\n", p)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(g.funcs.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// typeString 返回类型在生成的代码中的写法，并记录需要导入的包
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+		g.imports[p.Path()] = p.Name()
+		return p.Name()
+	})
+}
+
+// reflectString 返回与 reflect.Type.String() 相同的类型名称，用于错误信息
+func reflectString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		return p.Name()
+	})
+}
+
+func (g *generator) copier(fn string) string {
+	g.imports[copierPath] = "copier"
+	return "copier." + fn
+}
+
+// funcWriter 生成函数体，变量名称在函数内唯一
+type funcWriter struct {
+	buf    bytes.Buffer
+	indent int
+	vars   int
+}
+
+func (w *funcWriter) line(format string, args ...any) {
+	w.buf.WriteString(strings.Repeat("\t", w.indent))
+	fmt.Fprintf(&w.buf, format, args...)
+	w.buf.WriteByte('\n')
+}
+
+func (w *funcWriter) open(format string, args ...any) {
+	w.line(format, args...)
+	w.indent++
+}
+
+func (w *funcWriter) close(s string) {
+	w.indent--
+	w.line(s)
+}
+
+func (w *funcWriter) newVar(prefix string) string {
+	w.vars++
+	return prefix + strconv.Itoa(w.vars)
+}
+
+// returnErr 生成返回错误的语句，rel 为函数内的相对路径
+func (g *generator) returnErr(w *funcWriter, err, rel string) {
+	w.line("return %s(%s, %q)", g.copier("WithPath"), err, rel)
+}
+
+// copy 生成将 src 复制到 dst 的语句，规则与 copier.Copier 相同
+// rel 为函数内的相对路径，用于运行时的错误；path 为从根类型开始的路径，用于生成时的错误
+func (g *generator) copy(w *funcWriter, dst, src string, dt, st types.Type, rel, path string) error {
+	if types.Identical(st, dt) && isValueType(st) {
+		w.line("%s = %s", dst, src)
+		return nil
+	}
+
+	sp, sPtr := st.Underlying().(*types.Pointer)
+	dp, dPtr := dt.Underlying().(*types.Pointer)
+	switch {
+	case sPtr && dPtr:
+		v := w.newVar("v")
+		w.open("if %s == nil {", src)
+		w.line("%s = nil", dst)
+		w.close("} else {")
+		w.indent++
+		w.line("%s := new(%s)", v, g.typeString(dp.Elem()))
+		if err := g.copy(w, "*"+v, "*"+src, dp.Elem(), sp.Elem(), rel, path); err != nil {
+			return err
+		}
+		w.line("%s = %s", dst, v)
+		w.close("}")
+		return nil
+	case sPtr:
+		w.open("if %s == nil {", src)
+		w.line("%s = %s", dst, g.zero(dt))
+		w.close("} else {")
+		w.indent++
+		if err := g.copy(w, dst, "*"+src, dt, sp.Elem(), rel, path); err != nil {
+			return err
+		}
+		w.close("}")
+		return nil
+	case dPtr:
+		v := w.newVar("v")
+		w.line("%s := new(%s)", v, g.typeString(dp.Elem()))
+		if err := g.copy(w, "*"+v, src, dp.Elem(), st, rel, path); err != nil {
+			return err
+		}
+		w.line("%s = %s", dst, v)
+		return nil
+	}
+
+	switch su := st.Underlying().(type) {
+	case *types.Struct:
+		if du, ok := dt.Underlying().(*types.Struct); ok && !isOpaque(su) && !isOpaque(du) {
+			sn, sNamed := st.(*types.Named)
+			dn, dNamed := dt.(*types.Named)
+			if !sNamed || !dNamed {
+				return g.copyStruct(w, dst, src, du, su, rel, path)
+			}
+			name := g.funcName(pairKey{src: sn, dst: dn}, path)
+			w.open("if err := %s(%s, %s); err != nil {", name, addr(dst), addr(src))
+			if rel == "" {
+				w.line("return err")
+			} else {
+				g.returnErr(w, "err", rel)
+			}
+			w.close("}")
+			return nil
+		}
+	case *types.Slice, *types.Array:
+		if du, ok := dt.Underlying().(*types.Slice); ok {
+			return g.copySlice(w, dst, src, dt, du, st, rel, path)
+		}
+		sa, sArr := su.(*types.Array)
+		if da, ok := dt.Underlying().(*types.Array); ok && sArr && sa.Len() == da.Len() {
+			i := w.newVar("i")
+			w.open("for %s := range %s {", i, src)
+			if err := g.copy(w, index(dst, i), index(src, i), da.Elem(), sa.Elem(), rel+"[]", path+"[]"); err != nil {
+				return err
+			}
+			w.close("}")
+			return nil
+		}
+	case *types.Map:
+		if du, ok := dt.Underlying().(*types.Map); ok {
+			return g.copyMap(w, dst, src, dt, du, su, rel, path)
+		}
+	}
+
+	sb, sBasic := st.Underlying().(*types.Basic)
+	db, dBasic := dt.Underlying().(*types.Basic)
+	switch {
+	case types.AssignableTo(st, dt) && !isContainer(st):
+		// 接口、函数、通道以及没有导出字段的结构体只能浅拷贝
+		w.line("%s = %s", dst, src)
+		return nil
+	case sBasic && dBasic && isNumber(sb) && isNumber(db) && widens(sb, db):
+		w.line("%s = %s(%s)", dst, g.typeString(dt), src)
+		return nil
+	case sBasic && dBasic && sb.Kind() == types.Float64 && db.Kind() == types.Float32:
+		// 允许精度损失，只检查有限值是否超出 float32 的范围
+		g.imports["math"] = "math"
+		v := w.newVar("v")
+		w.line("%s := float64(%s)", v, src)
+		w.open("if !math.IsInf(%s, 0) && math.Abs(%s) > math.MaxFloat32 {", v, v)
+		g.returnErr(w, fmt.Sprintf("%s(%q, %s)", g.copier("InvalidValue"), reflectString(dt), src), rel)
+		w.close("}")
+		w.line("%s = %s(%s)", dst, g.typeString(dt), src)
+		return nil
+	case sBasic && dBasic && isNumber(sb) && isNumber(db):
+		v := w.newVar("v")
+		w.line("%s := %s(%s)", v, g.typeString(dt), src)
+		cond := fmt.Sprintf("%s(%s) != %s", g.typeString(st), v, src)
+		switch {
+		case isUnsigned(db) && !isUnsigned(sb):
+			cond = src + " < 0 || " + cond
+		case isUnsigned(sb) && !isUnsigned(db):
+			// 例如 uint64 的最大值 => int64 的 -1，转回来仍然相等
+			cond = v + " < 0 || " + cond
+		}
+		w.open("if %s {", cond)
+		g.returnErr(w, fmt.Sprintf("%s(%q, %s)", g.copier("InvalidValue"), reflectString(dt), src), rel)
+		w.close("}")
+		w.line("%s = %s", dst, v)
+		return nil
+	case sBasic && dBasic && sb.Kind() == db.Kind() && isBasic(sb):
+		w.line("%s = %s(%s)", dst, g.typeString(dt), src)
+		return nil
+	}
+	return errs.NewErrCopyField(fieldName(path), errs.NewErrInvalidType(reflectString(dt), reflectString(st)))
+}
+
+func (g *generator) copyStruct(w *funcWriter, dst, src string, dt, st *types.Struct, rel, path string) error {
+	srcFields := make(map[string]fieldInfo)
+	for _, f := range copyFields(st) {
+		srcFields[f.name] = f
+	}
+	for _, df := range copyFields(dt) {
+		sf, ok := srcFields[df.name]
+		if !ok {
+			continue
+		}
+		if err := g.copy(w, selector(dst, df.sel), selector(src, sf.sel), df.typ, sf.typ,
+			joinPath(rel, df.name), joinPath(path, df.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) copySlice(w *funcWriter, dst, src string, dt types.Type, du *types.Slice, st types.Type, rel, path string) error {
+	var elem types.Type
+	_, isSlice := st.Underlying().(*types.Slice)
+	if isSlice {
+		elem = st.Underlying().(*types.Slice).Elem()
+		w.open("if %s == nil {", src)
+		w.line("%s = nil", dst)
+		w.close("} else {")
+		w.indent++
+	} else {
+		elem = st.Underlying().(*types.Array).Elem()
+	}
+	v := w.newVar("v")
+	w.line("%s := make(%s, len(%s))", v, g.typeString(dt), src)
+	if types.Identical(elem, du.Elem()) && isValueType(elem) {
+		if isSlice {
+			w.line("copy(%s, %s)", v, src)
+		} else {
+			w.line("copy(%s, %s[:])", v, paren(src))
+		}
+	} else {
+		i := w.newVar("i")
+		w.open("for %s := range %s {", i, src)
+		if err := g.copy(w, v+"["+i+"]", index(src, i), du.Elem(), elem, rel+"[]", path+"[]"); err != nil {
+			return err
+		}
+		w.close("}")
+	}
+	w.line("%s = %s", dst, v)
+	if isSlice {
+		w.close("}")
+	}
+	return nil
+}
+
+func (g *generator) copyMap(w *funcWriter, dst, src string, dt types.Type, du, su *types.Map, rel, path string) error {
+	w.open("if %s == nil {", src)
+	w.line("%s = nil", dst)
+	w.close("} else {")
+	w.indent++
+	v := w.newVar("v")
+	w.line("%s := make(%s, len(%s))", v, g.typeString(dt), src)
+	k, e := w.newVar("k"), w.newVar("e")
+	w.open("for %s, %s := range %s {", k, e, src)
+	key, err := g.mapEntry(w, k, du.Key(), su.Key(), rel+"[key]", path+"[key]")
+	if err != nil {
+		return err
+	}
+	val, err := g.mapEntry(w, e, du.Elem(), su.Elem(), rel+"[]", path+"[]")
+	if err != nil {
+		return err
+	}
+	w.line("%s[%s] = %s", v, key, val)
+	w.close("}")
+	w.line("%s = %s", dst, v)
+	w.close("}")
+	return nil
+}
+
+// mapEntry 复制 map 的键或者值，类型相同时直接使用，否则复制到新的变量中
+func (g *generator) mapEntry(w *funcWriter, src string, dt, st types.Type, rel, path string) (string, error) {
+	if types.Identical(st, dt) && isValueType(st) {
+		return src, nil
+	}
+	v := w.newVar("v")
+	w.line("var %s %s", v, g.typeString(dt))
+	if err := g.copy(w, v, src, dt, st, rel, path); err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+// zero 返回类型的零值在生成的代码中的写法
+func (g *generator) zero(t types.Type) string {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		default:
+			return "0"
+		}
+	case *types.Struct, *types.Array:
+		return g.typeString(t) + "{}"
+	default:
+		return "nil"
+	}
+}
+
+// addr 返回表达式的地址，*v 的地址即为 v
+func addr(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return expr[1:]
+	}
+	return "&" + expr
+}
+
+// selector 返回字段选择表达式
+func selector(expr string, sel []string) string {
+	return paren(expr) + "." + strings.Join(sel, ".")
+}
+
+// index 返回下标表达式
+func index(expr, i string) string {
+	return paren(expr) + "[" + i + "]"
+}
+
+// paren 为 *v 加上括号，使其可以作为选择、下标表达式的操作数
+func paren(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// fieldInfo 参与复制的字段，name 为匹配名称，sel 为从外层结构体开始的字段名称
+type fieldInfo struct {
+	name string
+	sel  []string
+	typ  types.Type
+}
+
+// copyFields 与 copier 的字段匹配规则相同：
+// 没有标签的嵌入结构体（非指针）的字段会被提升，外层的同名字段优先
+func copyFields(st *types.Struct) []fieldInfo {
+	var res []fieldInfo
+	seen := make(map[string]struct{})
+	collectFields(st, nil, seen, &res)
+	return res
+}
+
+func collectFields(st *types.Struct, sel []string, seen map[string]struct{}, res *[]fieldInfo) {
+	var embedded []int
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i)).Get(copier.TagName)
+		if tag == "-" {
+			continue
+		}
+		if _, ok := f.Type().Underlying().(*types.Struct); ok && f.Embedded() && tag == "" {
+			embedded = append(embedded, i)
+			continue
+		}
+		if !f.Exported() {
+			continue
+		}
+		name := f.Name()
+		if tag != "" {
+			name = tag
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		*res = append(*res, fieldInfo{
+			name: name,
+			sel:  append(sel[:len(sel):len(sel)], f.Name()),
+			typ:  f.Type(),
+		})
+	}
+	for _, i := range embedded {
+		f := st.Field(i)
+		collectFields(f.Type().Underlying().(*types.Struct), append(sel[:len(sel):len(sel)], f.Name()), seen, res)
+	}
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// isValueType 类型中是否不包含需要深拷贝的指针、切片、map
+func isValueType(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map:
+		return false
+	case *types.Array:
+		return isValueType(u.Elem())
+	case *types.Struct:
+		if isOpaque(u) {
+			return true
+		}
+		for i := 0; i < u.NumFields(); i++ {
+			if !isValueType(u.Field(i).Type()) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// isOpaque 没有导出字段的结构体（例如 time.Time）被视为一个整体
+func isOpaque(st *types.Struct) bool {
+	return st.NumFields() > 0 && !hasExported(st)
+}
+
+func hasExported(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if f.Exported() {
+			return true
+		}
+		if es, ok := f.Type().Underlying().(*types.Struct); ok && f.Embedded() && hasExported(es) {
+			return true
+		}
+	}
+	return false
+}
+
+func isContainer(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Array:
+		return true
+	case *types.Struct:
+		return !isOpaque(u)
+	default:
+		return false
+	}
+}
+
+func isBasic(b *types.Basic) bool {
+	return b.Info()&(types.IsBoolean|types.IsString|types.IsComplex) != 0 || isNumber(b)
+}
+
+func isNumber(b *types.Basic) bool {
+	return b.Info()&(types.IsInteger|types.IsFloat) != 0 && b.Kind() != types.Uintptr && b.Info()&types.IsUntyped == 0
+}
+
+// widens 数字 src 转换为 dst 是否一定不会失败，此时不需要生成检查
+// 转换为浮点数时允许精度损失，只有 float64 => float32 可能超出范围；转换为整数时需要检查溢出和截断
+// int、uint 的位数与平台相关，作为 src 时按 64 位，作为 dst 时按 32 位计算
+func widens(src, dst *types.Basic) bool {
+	if dst.Info()&types.IsFloat != 0 {
+		return src.Kind() != types.Float64 || dst.Kind() != types.Float32
+	}
+	if src.Info()&dst.Info()&types.IsInteger == 0 {
+		return false
+	}
+	if src.Kind() == dst.Kind() {
+		return true
+	}
+	sBits, dBits := intBits(src, 64), intBits(dst, 32)
+	switch {
+	case isUnsigned(src) == isUnsigned(dst):
+		return dBits >= sBits
+	case isUnsigned(src):
+		return dBits > sBits
+	default:
+		return false
+	}
+}
+
+func intBits(b *types.Basic, platform int) int {
+	switch b.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32:
+		return 32
+	case types.Int64, types.Uint64:
+		return 64
+	default:
+		return platform
+	}
+}
+
+func isUnsigned(b *types.Basic) bool {
+	return b.Info()&types.IsUnsigned != 0 && b.Kind() != types.Uintptr
+}
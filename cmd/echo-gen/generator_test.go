@@ -0,0 +1,170 @@
+/**
+ * Description：
+ * FileName：generator_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 21:08:33
+ * Remark：
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "更新 golden 文件")
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		dir     string
+		pairs   []pair.Pair[string, string]
+		exclude string
+		golden  string
+	}{
+		{
+			name:   "basic",
+			dir:    "testdata/basic",
+			pairs:  []pair.Pair[string, string]{pair.NewPair("User", "UserDTO")},
+			golden: "testdata/basic.golden",
+		},
+		{
+			name:   "nested",
+			dir:    "testdata/nested",
+			pairs:  []pair.Pair[string, string]{pair.NewPair("Order", "OrderDTO")},
+			golden: "testdata/nested.golden",
+		},
+		{
+			name:   "float",
+			dir:    "testdata/float",
+			pairs:  []pair.Pair[string, string]{pair.NewPair("Measure", "MeasureDTO")},
+			golden: "testdata/float.golden",
+		},
+		{
+			// 提交的生成文件必须与当前的生成器保持一致，它的行为由 sample 中的测试与 copier 对比
+			name:    "sample",
+			dir:     "internal/sample",
+			pairs:   []pair.Pair[string, string]{pair.NewPair("User", "UserDTO"), pair.NewPair("Node", "NodeDTO")},
+			exclude: "copier_gen.go",
+			golden:  "internal/sample/copier_gen.go",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Generate(tc.dir, tc.pairs, tc.exclude)
+			require.NoError(t, err)
+			if *update {
+				require.NoError(t, os.WriteFile(tc.golden, got, 0o644))
+			}
+			want, err := os.ReadFile(tc.golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got))
+		})
+	}
+}
+
+func TestGenerateErr(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pair    pair.Pair[string, string]
+		wantErr error
+	}{
+		{
+			name:    "needs converter",
+			pair:    pair.NewPair("User", "UserDTO"),
+			wantErr: errs.NewErrCopyField("CreatedAt", errs.NewErrInvalidType("string", "time.Time")),
+		},
+		{
+			name:    "nested mismatch",
+			pair:    pair.NewPair("UserInner", "UserInnerDTO"),
+			wantErr: errs.NewErrCopyField("Inner.Tags", errs.NewErrInvalidType("map[string]string", "[]string")),
+		},
+		{
+			name:    "unknown type",
+			pair:    pair.NewPair("User", "Unknown"),
+			wantErr: errors.New("echo-gen: 包 invalid 中没有找到类型 Unknown"),
+		},
+		{
+			name:    "not struct",
+			pair:    pair.NewPair("NotStruct", "UserDTO"),
+			wantErr: errors.New("echo-gen: NotStruct 不是非泛型的结构体"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Generate("testdata/invalid", []pair.Pair[string, string]{tc.pair}, "")
+			assert.EqualError(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	src, err := os.ReadFile("testdata/basic/model.go")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "model.go"), src, 0o644))
+	// 已经存在的生成文件会被忽略，即使它无法通过编译
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "copier_gen.go"), []byte("package basic\n\nvar x = undefined\n"), 0o644))
+
+	pairs := []pair.Pair[string, string]{pair.NewPair("User", "UserDTO")}
+	require.NoError(t, run(dir, pairs, "copier_gen.go"))
+	got, err := os.ReadFile(filepath.Join(dir, "copier_gen.go"))
+	require.NoError(t, err)
+	want, err := os.ReadFile("testdata/basic.golden")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+}
+
+func TestParsePairs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		s       string
+		want    []pair.Pair[string, string]
+		wantErr bool
+	}{
+		{
+			name: "single",
+			s:    "User:UserDTO",
+			want: []pair.Pair[string, string]{pair.NewPair("User", "UserDTO")},
+		},
+		{
+			name: "multiple",
+			s:    "User:UserDTO, Node:NodeDTO",
+			want: []pair.Pair[string, string]{pair.NewPair("User", "UserDTO"), pair.NewPair("Node", "NodeDTO")},
+		},
+		{
+			name:    "empty",
+			s:       "",
+			wantErr: true,
+		},
+		{
+			name:    "missing dst",
+			s:       "User:",
+			wantErr: true,
+		},
+		{
+			name:    "missing colon",
+			s:       "User",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parsePairs(tc.s)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Code generated by echo-gen. DO NOT EDIT.
+
+package sample
+
+import (
+	"github.com/carefuly/careful-echo/bean/copier"
+	"math"
+)
+
+// CopyUserToUserDTO 将 src 复制到 dst，dst 中没有匹配的字段保持不变
+// 与 copier.NewCopier[User, UserDTO]() 的 CopyTo 行为一致
+func CopyUserToUserDTO(dst *UserDTO, src *User) error {
+	dst.ID = src.ID
+	dst.Name = src.Name
+	dst.EmailAddress = src.Email
+	v1 := int8(src.Age)
+	if int32(v1) != src.Age {
+		return copier.WithPath(copier.InvalidValue("int8", src.Age), "Age")
+	}
+	dst.Age = v1
+	v2 := int64(src.Quota)
+	if v2 < 0 || uint64(v2) != src.Quota {
+		return copier.WithPath(copier.InvalidValue("int64", src.Quota), "Quota")
+	}
+	dst.Quota = v2
+	v3 := float64(src.Price)
+	if !math.IsInf(v3, 0) && math.Abs(v3) > math.MaxFloat32 {
+		return copier.WithPath(copier.InvalidValue("float32", src.Price), "Price")
+	}
+	dst.Price = float32(src.Price)
+	dst.Status = int(src.Status)
+	if src.Tags == nil {
+		dst.Tags = nil
+	} else {
+		v4 := make([]string, len(src.Tags))
+		copy(v4, src.Tags)
+		dst.Tags = v4
+	}
+	if src.Address == nil {
+		dst.Address = AddressDTO{}
+	} else {
+		if err := copyAddressToAddressDTO(&dst.Address, src.Address); err != nil {
+			return copier.WithPath(err, "Address")
+		}
+	}
+	v5 := make([]*AddressDTO, len(src.Addresses))
+	for i6 := range src.Addresses {
+		v7 := new(AddressDTO)
+		if err := copyAddressToAddressDTO(v7, &src.Addresses[i6]); err != nil {
+			return copier.WithPath(err, "Addresses[]")
+		}
+		v5[i6] = v7
+	}
+	dst.Addresses = v5
+	if src.Scores == nil {
+		dst.Scores = nil
+	} else {
+		v8 := make(map[string]uint, len(src.Scores))
+		for k9, e10 := range src.Scores {
+			var v11 uint
+			v12 := uint(e10)
+			if e10 < 0 || int(v12) != e10 {
+				return copier.WithPath(copier.InvalidValue("uint", e10), "Scores[]")
+			}
+			v11 = v12
+			v8[k9] = v11
+		}
+		dst.Scores = v8
+	}
+	if src.Labels == nil {
+		dst.Labels = nil
+	} else {
+		v13 := make(map[int][]string, len(src.Labels))
+		for k14, e15 := range src.Labels {
+			var v16 int
+			v16 = int(k14)
+			var v17 []string
+			if e15 == nil {
+				v17 = nil
+			} else {
+				v18 := make([]string, len(e15))
+				copy(v18, e15)
+				v17 = v18
+			}
+			v13[v16] = v17
+		}
+		dst.Labels = v13
+	}
+	dst.CreatedAt = src.CreatedAt
+	if src.Friends == nil {
+		dst.Friends = nil
+	} else {
+		v19 := make([]*UserDTO, len(src.Friends))
+		for i20 := range src.Friends {
+			v21 := new(UserDTO)
+			if err := CopyUserToUserDTO(v21, &src.Friends[i20]); err != nil {
+				return copier.WithPath(err, "Friends[]")
+			}
+			v19[i20] = v21
+		}
+		dst.Friends = v19
+	}
+	if src.Manager == nil {
+		dst.Manager = nil
+	} else {
+		v22 := new(UserDTO)
+		if err := CopyUserToUserDTO(v22, src.Manager); err != nil {
+			return copier.WithPath(err, "Manager")
+		}
+		dst.Manager = v22
+	}
+	dst.CreatedBy = src.base.CreatedBy
+	return nil
+}
+
+// CopyNodeToNodeDTO 将 src 复制到 dst，dst 中没有匹配的字段保持不变
+// 与 copier.NewCopier[Node, NodeDTO]() 的 CopyTo 行为一致
+func CopyNodeToNodeDTO(dst *NodeDTO, src *Node) error {
+	dst.Val = int64(src.Val)
+	if src.Next == nil {
+		dst.Next = nil
+	} else {
+		v1 := new(NodeDTO)
+		if err := CopyNodeToNodeDTO(v1, src.Next); err != nil {
+			return copier.WithPath(err, "Next")
+		}
+		dst.Next = v1
+	}
+	if src.Children == nil {
+		dst.Children = nil
+	} else {
+		v2 := make([]*NodeDTO, len(src.Children))
+		for i3 := range src.Children {
+			v4 := new(NodeDTO)
+			if err := CopyNodeToNodeDTO(v4, &src.Children[i3]); err != nil {
+				return copier.WithPath(err, "Children[]")
+			}
+			v2[i3] = v4
+		}
+		dst.Children = v2
+	}
+	return nil
+}
+
+func copyAddressToAddressDTO(dst *AddressDTO, src *Address) error {
+	dst.City = src.City
+	return nil
+}
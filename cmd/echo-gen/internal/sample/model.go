@@ -0,0 +1,83 @@
+/**
+ * Description：
+ * FileName：model.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 20:36:52
+ * Remark：
+ */
+
+// Package sample 用于验证 echo-gen 生成的代码与 bean/copier 的行为一致
+package sample
+
+import "time"
+
+//go:generate go run github.com/carefuly/careful-echo/cmd/echo-gen -type User:UserDTO,Node:NodeDTO
+
+type Status int
+
+type base struct {
+	CreatedBy string
+}
+
+type Address struct {
+	City string
+	Zip  string
+}
+
+type AddressDTO struct {
+	City string
+}
+
+type User struct {
+	base
+	ID        int64
+	Name      string
+	Email     string `copier:"EmailAddress"`
+	Password  string `copier:"-"`
+	Age       int32
+	Quota     uint64
+	Price     float64
+	Status    Status
+	Tags      []string
+	Address   *Address
+	Addresses [2]Address
+	Scores    map[string]int
+	Labels    map[Status][]string
+	CreatedAt time.Time
+	Friends   []User
+	Manager   *User
+	secret    string
+}
+
+type UserDTO struct {
+	ID           int64
+	Name         string
+	EmailAddress string
+	Password     string
+	Age          int8
+	Quota        int64
+	Price        float32
+	Status       int
+	Tags         []string
+	Address      AddressDTO
+	Addresses    []*AddressDTO
+	Scores       map[string]uint
+	Labels       map[int][]string
+	CreatedAt    time.Time
+	Friends      []*UserDTO
+	Manager      *UserDTO
+	CreatedBy    string
+	Extra        string
+}
+
+type Node struct {
+	Val      int
+	Next     *Node
+	Children []Node
+}
+
+type NodeDTO struct {
+	Val      int64
+	Next     *NodeDTO
+	Children []*NodeDTO
+}
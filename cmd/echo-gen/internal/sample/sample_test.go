@@ -0,0 +1,172 @@
+/**
+ * Description：
+ * FileName：sample_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 20:51:09
+ * Remark：
+ */
+
+package sample
+
+import (
+	"github.com/carefuly/careful-echo/bean/copier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+	"time"
+)
+
+func newUser() User {
+	return User{
+		base:      base{CreatedBy: "admin"},
+		ID:        1,
+		Name:      "Tom",
+		Email:     "tom@example.com",
+		Password:  "123456",
+		Age:       18,
+		Quota:     1024,
+		Price:     0.1,
+		Status:    2,
+		Tags:      []string{"a", "b"},
+		Address:   &Address{City: "上海", Zip: "200000"},
+		Addresses: [2]Address{{City: "北京"}, {City: "深圳"}},
+		Scores:    map[string]int{"math": 90},
+		Labels:    map[Status][]string{1: {"x"}, 2: nil},
+		CreatedAt: time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC),
+		Friends:   []User{{ID: 2, Name: "Jerry", Tags: []string{}}},
+		Manager:   &User{ID: 3, Manager: &User{ID: 4}},
+		secret:    "secret",
+	}
+}
+
+// TestParity 生成的代码与 copier 的结果、错误必须完全一致
+func TestParity(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  func() User
+		dst  UserDTO
+	}{
+		{
+			name: "full",
+			src:  newUser,
+		},
+		{
+			name: "zero",
+			src:  func() User { return User{} },
+		},
+		{
+			name: "keep unmatched fields",
+			src:  func() User { return User{Name: "Tom"} },
+			dst:  UserDTO{Password: "keep", Extra: "keep", Address: AddressDTO{City: "北京"}},
+		},
+		{
+			name: "overflow",
+			src: func() User {
+				u := newUser()
+				u.Age = 200
+				return u
+			},
+		},
+		{
+			name: "unsigned overflow",
+			src: func() User {
+				u := newUser()
+				u.Quota = math.MaxUint64
+				return u
+			},
+		},
+		{
+			name: "float out of range",
+			src: func() User {
+				u := newUser()
+				u.Price = 1e39
+				return u
+			},
+		},
+		{
+			name: "float infinity",
+			src: func() User {
+				u := newUser()
+				u.Price = math.Inf(-1)
+				return u
+			},
+		},
+		{
+			name: "negative to unsigned in map",
+			src: func() User {
+				u := newUser()
+				u.Scores["art"] = -1
+				return u
+			},
+		},
+		{
+			name: "nested error",
+			src: func() User {
+				u := newUser()
+				u.Friends = append(u.Friends, User{Age: 128})
+				return u
+			},
+		},
+		{
+			name: "recursive error",
+			src: func() User {
+				u := newUser()
+				u.Manager.Manager.Age = -129
+				return u
+			},
+		},
+	}
+	c, err := copier.NewCopier[User, UserDTO]()
+	require.NoError(t, err)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := tc.dst
+			wantErr := c.CopyTo(&want, tc.src())
+			got := tc.dst
+			src := tc.src()
+			gotErr := CopyUserToUserDTO(&got, &src)
+			assert.Equal(t, wantErr, gotErr)
+			if wantErr == nil {
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestParityNode(t *testing.T) {
+	src := Node{
+		Val:      1,
+		Next:     &Node{Val: 2, Next: &Node{Val: 3}},
+		Children: []Node{{Val: 4, Children: []Node{{Val: 5}}}},
+	}
+	want, err := copier.Copy[Node, NodeDTO](src)
+	require.NoError(t, err)
+	var got NodeDTO
+	require.NoError(t, CopyNodeToNodeDTO(&got, &src))
+	assert.Equal(t, want, got)
+
+	// 深拷贝
+	src.Children[0].Val = 0
+	assert.Equal(t, int64(4), got.Children[0].Val)
+}
+
+func BenchmarkCopy(b *testing.B) {
+	src := newUser()
+	b.Run("copier", func(b *testing.B) {
+		c, err := copier.NewCopier[User, UserDTO]()
+		require.NoError(b, err)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var dst UserDTO
+			_ = c.CopyTo(&dst, src)
+		}
+	})
+	b.Run("echo-gen", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var dst UserDTO
+			_ = CopyUserToUserDTO(&dst, &src)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+/**
+ * Description：
+ * FileName：main.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 19:31:05
+ * Remark：
+ */
+
+// echo-gen 为结构体生成不使用反射的复制函数，复制规则与 bean/copier 相同
+//
+// 用法：
+//
+//	echo-gen -type User:UserDTO,Order:OrderDTO [-output copier_gen.go] [dir]
+//
+// 通常配合 go:generate 使用：
+//
+//	//go:generate go run github.com/carefuly/careful-echo/cmd/echo-gen -type User:UserDTO
+//
+// 每一对类型生成一个 func CopyUserToUserDTO(dst *UserDTO, src *User) error
+// 嵌套的结构体会生成未导出的辅助函数；生成的代码不支持 copier.WithConverters，
+// 需要转换器才能复制的字段会导致生成失败
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typ := flag.String("type", "", "逗号分隔的类型对，例如 User:UserDTO,Order:OrderDTO")
+	output := flag.String("output", "copier_gen.go", "生成的文件名，相对于包目录")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "用法：echo-gen -type Src:Dst[,Src:Dst...] [-output file] [dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	pairs, err := parsePairs(*typ)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	if err := run(dir, pairs, *output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, pairs []pair.Pair[string, string], output string) error {
+	src, err := Generate(dir, pairs, output)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, output), src, 0o644)
+}
+
+// parsePairs 解析 -type 参数，例如 "User:UserDTO,Order:OrderDTO"
+func parsePairs(s string) ([]pair.Pair[string, string], error) {
+	if s == "" {
+		return nil, fmt.Errorf("echo-gen: 缺少 -type 参数")
+	}
+	var res []pair.Pair[string, string]
+	for _, item := range strings.Split(s, ",") {
+		src, dst, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok || src == "" || dst == "" {
+			return nil, fmt.Errorf("echo-gen: 无效的类型对 %q，格式为 Src:Dst", item)
+		}
+		res = append(res, pair.NewPair(src, dst))
+	}
+	return res, nil
+}
@@ -0,0 +1,33 @@
+package basic
+
+type Status int
+
+type base struct {
+	CreatedBy string
+}
+
+type User struct {
+	base
+	ID       int64
+	Name     string
+	Email    string `copier:"EmailAddress"`
+	Password string `copier:"-"`
+	Age      int32
+	Score    float64
+	Status   Status
+	Active   bool
+	secret   string
+}
+
+type UserDTO struct {
+	ID           int64
+	Name         string
+	EmailAddress string
+	Password     string
+	Age          uint8
+	Score        float32
+	Status       int
+	Active       bool
+	CreatedBy    string
+	Extra        string
+}
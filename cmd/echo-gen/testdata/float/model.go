@@ -0,0 +1,19 @@
+package float
+
+type Celsius float64
+
+type Measure struct {
+	Ratio  float64
+	Weight float32
+	Count  int64
+	Level  float64
+	Temp   Celsius
+}
+
+type MeasureDTO struct {
+	Ratio  float32
+	Weight float64
+	Count  float32
+	Level  int
+	Temp   float32
+}
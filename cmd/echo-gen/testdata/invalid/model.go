@@ -0,0 +1,32 @@
+package invalid
+
+import "time"
+
+type User struct {
+	Name      string
+	CreatedAt time.Time
+	Inner     Inner
+}
+
+type Inner struct {
+	Tags []string
+}
+
+type UserDTO struct {
+	Name      string
+	CreatedAt string
+}
+
+type InnerDTO struct {
+	Tags map[string]string
+}
+
+type UserInner struct {
+	Inner Inner
+}
+
+type UserInnerDTO struct {
+	Inner InnerDTO
+}
+
+type NotStruct int
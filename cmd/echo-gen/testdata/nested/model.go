@@ -0,0 +1,54 @@
+package nested
+
+import "time"
+
+type Address struct {
+	City string
+	Zip  string
+}
+
+type AddressDTO struct {
+	City string
+}
+
+type Order struct {
+	ID        int64
+	Address   *Address
+	Backup    Address
+	Items     []Item
+	Tags      []string
+	Codes     [3]int32
+	Points    [2]Address
+	Meta      map[string]int
+	Groups    map[int32][]Item
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	Parent    *Order
+	Extra     any
+}
+
+type Item struct {
+	SKU   string
+	Count int
+}
+
+type OrderDTO struct {
+	ID        int64
+	Address   AddressDTO
+	Backup    *AddressDTO
+	Items     []*ItemDTO
+	Tags      []string
+	Codes     []int64
+	Points    [2]AddressDTO
+	Meta      map[string]int64
+	Groups    map[int64][]ItemDTO
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Parent    *OrderDTO
+	Extra     any
+}
+
+type ItemDTO struct {
+	SKU   string
+	Count int16
+}
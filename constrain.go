@@ -16,6 +16,17 @@ type RealNumber interface {
 		~float32 | ~float64
 }
 
+// Integer 整数
+type Integer interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Float 浮点数
+type Float interface {
+	~float32 | ~float64
+}
+
 type Number interface {
 	RealNumber | ~complex64 | ~complex128
 }
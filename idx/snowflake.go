@@ -0,0 +1,166 @@
+/**
+ * Description：
+ * FileName：snowflake.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 19:02:51
+ * Remark：
+ */
+
+package idx
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"sync"
+	"time"
+)
+
+const (
+	// 默认的起始时间 2025-01-01 00:00:00 UTC
+	defaultSnowflakeEpoch = 1735689600000
+	defaultWorkerBits     = 10
+	defaultSequenceBits   = 12
+	// maxNodeBits 机器位与序列号位之和的上限，保证时间戳至少有 41 位（约 69 年）
+	maxNodeBits = 22
+)
+
+var (
+	errInvalidSnowflakeBits = errors.New("echo: 雪花算法的机器位与序列号位之和必须在 [1, 22] 内，且序列号位至少为 1")
+	errSnowflakeTimeRange   = errors.New("echo: 当前时间早于起始时间或超出了雪花算法时间戳的表示范围")
+)
+
+// Snowflake 雪花算法 ID 生成器，并发安全
+// 生成的 ID 为 63 位正整数，从高位到低位依次为：时间戳（毫秒）| 机器 ID | 序列号
+// 同一毫秒内序列号用尽时会等待到下一毫秒
+type Snowflake struct {
+	lock sync.Mutex
+
+	epoch        int64
+	workerBits   uint8
+	sequenceBits uint8
+	workerID     int64
+
+	lastMs   int64
+	sequence int64
+	now      func() time.Time
+}
+
+// SnowflakeParts 雪花算法 ID 解析后的各个部分
+type SnowflakeParts struct {
+	// Time 生成时间（毫秒精度）
+	Time time.Time
+	// WorkerID 机器 ID
+	WorkerID int64
+	// Sequence 同一毫秒内的序列号
+	Sequence int64
+}
+
+// NewSnowflake 创建一个雪花算法 ID 生成器
+// 默认起始时间为 2025-01-01 00:00:00 UTC，机器位 10 位，序列号位 12 位
+// workerID 超出机器位能表示的范围时返回错误
+func NewSnowflake(workerID int64, opts ...option.OptionErr[Snowflake]) (*Snowflake, error) {
+	s := &Snowflake{
+		epoch:        defaultSnowflakeEpoch,
+		workerBits:   defaultWorkerBits,
+		sequenceBits: defaultSequenceBits,
+		workerID:     workerID,
+		now:          time.Now,
+	}
+	if err := option.ApplyErr(s, opts...); err != nil {
+		return nil, err
+	}
+	if s.sequenceBits == 0 || s.workerBits+s.sequenceBits > maxNodeBits {
+		return nil, errInvalidSnowflakeBits
+	}
+	if maxWorkerID := int64(1)<<s.workerBits - 1; workerID < 0 || workerID > maxWorkerID {
+		return nil, errs.NewErrInvalidWorkerID(workerID, maxWorkerID)
+	}
+	return s, nil
+}
+
+// WithEpoch 设置起始时间，时间戳部分记录的是与起始时间的毫秒差
+// 起始时间不能晚于当前时间，同一套系统中的所有生成器必须使用相同的起始时间
+func WithEpoch(epoch time.Time) option.OptionErr[Snowflake] {
+	return func(s *Snowflake) error {
+		if epoch.After(time.Now()) {
+			return errSnowflakeTimeRange
+		}
+		s.epoch = epoch.UnixMilli()
+		return nil
+	}
+}
+
+// WithWorkerBits 设置机器 ID 占用的位数，决定了最多可以部署多少个生成器
+func WithWorkerBits(bits uint8) option.OptionErr[Snowflake] {
+	return func(s *Snowflake) error {
+		if bits > maxNodeBits {
+			return errInvalidSnowflakeBits
+		}
+		s.workerBits = bits
+		return nil
+	}
+}
+
+// WithSequenceBits 设置序列号占用的位数，决定了每毫秒最多可以生成多少个 ID
+func WithSequenceBits(bits uint8) option.OptionErr[Snowflake] {
+	return func(s *Snowflake) error {
+		if bits == 0 || bits > maxNodeBits {
+			return errInvalidSnowflakeBits
+		}
+		s.sequenceBits = bits
+		return nil
+	}
+}
+
+// Next 生成下一个 ID
+// 检测到时钟回拨时返回错误，而不是生成可能重复的 ID
+func (s *Snowflake) Next() (int64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ms := s.now().UnixMilli()
+	if ms < s.lastMs {
+		return 0, errs.NewErrClockMovedBackwards(s.lastMs, ms)
+	}
+
+	var seq int64
+	if ms == s.lastMs {
+		seq = (s.sequence + 1) & s.maxSequence()
+		if seq == 0 {
+			// 当前毫秒的序列号已经用尽，等待下一毫秒
+			for ms <= s.lastMs {
+				time.Sleep(time.Until(time.UnixMilli(s.lastMs + 1)))
+				ms = s.now().UnixMilli()
+			}
+		}
+	}
+
+	// 先检查时间范围再更新状态，失败的调用不会影响生成器
+	elapsed := ms - s.epoch
+	if elapsed < 0 || elapsed >= int64(1)<<s.timeBits() {
+		return 0, errSnowflakeTimeRange
+	}
+	s.sequence, s.lastMs = seq, ms
+
+	return elapsed<<(s.workerBits+s.sequenceBits) |
+		s.workerID<<s.sequenceBits |
+		seq, nil
+}
+
+// Parse 解析由相同配置的生成器生成的 ID
+func (s *Snowflake) Parse(id int64) SnowflakeParts {
+	return SnowflakeParts{
+		Time:     time.UnixMilli(id>>(s.workerBits+s.sequenceBits) + s.epoch),
+		WorkerID: id >> s.sequenceBits & (int64(1)<<s.workerBits - 1),
+		Sequence: id & s.maxSequence(),
+	}
+}
+
+func (s *Snowflake) maxSequence() int64 {
+	return int64(1)<<s.sequenceBits - 1
+}
+
+func (s *Snowflake) timeBits() uint8 {
+	return 63 - s.workerBits - s.sequenceBits
+}
@@ -0,0 +1,179 @@
+/**
+ * Description：
+ * FileName：snowflake_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 19:27:13
+ * Remark：
+ */
+
+package idx
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewSnowflake(t *testing.T) {
+	testCases := []struct {
+		name     string
+		workerID int64
+		opts     []option.OptionErr[Snowflake]
+		wantErr  error
+	}{
+		{
+			name:     "default",
+			workerID: 1023,
+		},
+		{
+			name:     "worker id out of range",
+			workerID: 1024,
+			wantErr:  errs.NewErrInvalidWorkerID(1024, 1023),
+		},
+		{
+			name:     "negative worker id",
+			workerID: -1,
+			wantErr:  errs.NewErrInvalidWorkerID(-1, 1023),
+		},
+		{
+			name:     "custom bits",
+			workerID: 31,
+			opts:     []option.OptionErr[Snowflake]{WithWorkerBits(5), WithSequenceBits(8)},
+		},
+		{
+			name:    "zero worker bits",
+			opts:    []option.OptionErr[Snowflake]{WithWorkerBits(0)},
+			wantErr: nil,
+		},
+		{
+			name:    "zero sequence bits",
+			opts:    []option.OptionErr[Snowflake]{WithSequenceBits(0)},
+			wantErr: errInvalidSnowflakeBits,
+		},
+		{
+			name:    "too many bits",
+			opts:    []option.OptionErr[Snowflake]{WithWorkerBits(12), WithSequenceBits(12)},
+			wantErr: errInvalidSnowflakeBits,
+		},
+		{
+			name:    "epoch in future",
+			opts:    []option.OptionErr[Snowflake]{WithEpoch(time.Now().Add(time.Hour))},
+			wantErr: errSnowflakeTimeRange,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewSnowflake(tc.workerID, tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			id, err := s.Next()
+			require.NoError(t, err)
+			assert.Positive(t, id)
+			assert.Equal(t, tc.workerID, s.Parse(id).WorkerID)
+		})
+	}
+}
+
+func TestSnowflakeNext(t *testing.T) {
+	epoch := time.UnixMilli(1700000000000)
+	now := time.UnixMilli(1760000000000)
+	s, err := NewSnowflake(5, WithEpoch(epoch), WithWorkerBits(4), WithSequenceBits(2))
+	require.NoError(t, err)
+	s.now = func() time.Time {
+		return now
+	}
+
+	// 同一毫秒内序列号递增
+	for i := int64(0); i < 4; i++ {
+		id, err := s.Next()
+		require.NoError(t, err)
+		assert.Equal(t, SnowflakeParts{Time: now, WorkerID: 5, Sequence: i}, s.Parse(id))
+		assert.Equal(t, (now.UnixMilli()-epoch.UnixMilli())<<6|5<<2|i, id)
+	}
+
+	// 时钟回拨
+	now = now.Add(-time.Millisecond)
+	_, err = s.Next()
+	assert.Equal(t, errs.NewErrClockMovedBackwards(1760000000000, 1759999999999), err)
+
+	// 新的毫秒序列号归零
+	now = now.Add(2 * time.Millisecond)
+	id, err := s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, SnowflakeParts{Time: now, WorkerID: 5, Sequence: 0}, s.Parse(id))
+}
+
+func TestSnowflakeTimeOverflow(t *testing.T) {
+	s, err := NewSnowflake(0, WithWorkerBits(11), WithSequenceBits(11))
+	require.NoError(t, err)
+	now := time.UnixMilli(defaultSnowflakeEpoch + 1<<41 - 1)
+	s.now = func() time.Time {
+		return now
+	}
+	for i := 0; i < 2; i++ {
+		_, err = s.Next()
+		require.NoError(t, err)
+	}
+	sequence, lastMs := s.sequence, s.lastMs
+
+	// 时间戳只有 41 位，约 69 年后溢出
+	now = now.Add(time.Millisecond)
+	_, err = s.Next()
+	assert.Equal(t, errSnowflakeTimeRange, err)
+	// 失败的调用不会改变生成器的状态
+	assert.Equal(t, sequence, s.sequence)
+	assert.Equal(t, lastMs, s.lastMs)
+
+	now = now.Add(-time.Millisecond)
+	id, err := s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, SnowflakeParts{Time: now, WorkerID: 0, Sequence: 2}, s.Parse(id))
+}
+
+func TestSnowflakeSequenceExhausted(t *testing.T) {
+	s, err := NewSnowflake(1, WithSequenceBits(1))
+	require.NoError(t, err)
+
+	// 每毫秒只能生成 2 个 ID，序列号用尽时会等待下一毫秒
+	ids := make([]int64, 0, 10)
+	for i := 0; i < 10; i++ {
+		id, err := s.Next()
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	for i := 1; i < len(ids); i++ {
+		assert.Greater(t, ids[i], ids[i-1])
+	}
+}
+
+func TestSnowflakeConcurrent(t *testing.T) {
+	s, err := NewSnowflake(1)
+	require.NoError(t, err)
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		seen = make(map[int64]struct{}, 8000)
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				id, err := s.Next()
+				assert.NoError(t, err)
+				lock.Lock()
+				seen[id] = struct{}{}
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, seen, 8000)
+}
@@ -0,0 +1,180 @@
+/**
+ * Description：
+ * FileName：ulid.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 18:34:17
+ * Remark：
+ */
+
+package idx
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+)
+
+// crockfordAlphabet Crockford Base32 字符表，去掉了容易混淆的 I、L、O、U
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var (
+	errInvalidULID  = errors.New("echo: 无效的 ULID")
+	errULIDOverflow = errors.New("echo: 同一毫秒内生成的 ULID 过多，随机部分已溢出")
+
+	// crockfordDecoding Crockford Base32 解码表，不区分大小写，0xFF 表示非法字符
+	crockfordDecoding = func() [256]byte {
+		var res [256]byte
+		for i := range res {
+			res[i] = 0xFF
+		}
+		for i := 0; i < len(crockfordAlphabet); i++ {
+			c := crockfordAlphabet[i]
+			res[c] = byte(i)
+			if c >= 'A' && c <= 'Z' {
+				res[c+'a'-'A'] = byte(i)
+			}
+		}
+		return res
+	}()
+)
+
+// ULID 可按字典序排序的 128 位唯一标识
+// 高 48 位为毫秒级 Unix 时间戳，低 80 位为随机数
+// 字符串形式为 26 位 Crockford Base32 编码
+type ULID [16]byte
+
+// NewULID 生成一个 ULID，随机部分来自 crypto/rand
+// 同一毫秒内生成的多个 ULID 之间的顺序是随机的，需要严格递增时请使用 ULIDGenerator
+func NewULID() ULID {
+	var u ULID
+	putMillis(u[:], time.Now().UnixMilli())
+	readRandom(u[6:])
+	return u
+}
+
+// ULIDGenerator 单调递增的 ULID 生成器，并发安全
+// 同一毫秒内生成的 ULID 在上一个的随机部分上加一，保证严格递增
+// 零值可以直接使用，与 NewULIDGenerator 创建的生成器等价
+type ULIDGenerator struct {
+	lock   sync.Mutex
+	lastMs int64
+	last   ULID
+	now    func() time.Time
+}
+
+// NewULIDGenerator 创建一个单调递增的 ULID 生成器
+func NewULIDGenerator() *ULIDGenerator {
+	return &ULIDGenerator{
+		now: time.Now,
+	}
+}
+
+// Next 生成下一个 ULID
+// 同一毫秒内随机部分溢出（概率极低）时返回错误，直到时间戳前进之前都会返回该错误
+// 时钟回拨时继续沿用上一次的时间戳并递增随机部分，保证结果仍然递增
+func (g *ULIDGenerator) Next() (ULID, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	now := g.now
+	if now == nil {
+		now = time.Now
+	}
+	ms := now().UnixMilli()
+	if ms > g.lastMs {
+		g.lastMs = ms
+		putMillis(g.last[:], ms)
+		readRandom(g.last[6:])
+		return g.last, nil
+	}
+
+	// 在副本上按大端序给随机部分加一，溢出时 g.last 保持不变，之后的调用仍然返回错误
+	next := g.last
+	for i := len(next) - 1; i >= 6; i-- {
+		next[i]++
+		if next[i] != 0 {
+			g.last = next
+			return next, nil
+		}
+	}
+	return ULID{}, errULIDOverflow
+}
+
+// ParseULID 解析 26 位 Crockford Base32 编码的 ULID 字符串，不区分大小写
+func ParseULID(s string) (ULID, error) {
+	if len(s) != 26 {
+		return ULID{}, errInvalidULID
+	}
+	// 26 个字符共 130 位，最高 2 位必须为 0，因此第一个字符不能超过 7
+	if v := crockfordDecoding[s[0]]; v > 7 {
+		return ULID{}, errInvalidULID
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		v := crockfordDecoding[s[i]]
+		if v == 0xFF {
+			return ULID{}, errInvalidULID
+		}
+		// 整体左移 5 位后加上当前字符的值
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+
+	var u ULID
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+	return u, nil
+}
+
+// String 返回 26 位 Crockford Base32 编码的字符串
+func (u ULID) String() string {
+	hi, lo := binary.BigEndian.Uint64(u[:8]), binary.BigEndian.Uint64(u[8:])
+	var buf [26]byte
+	for i := len(buf) - 1; i >= 0; i-- {
+		buf[i] = crockfordAlphabet[lo&31]
+		// 整体右移 5 位
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(buf[:])
+}
+
+// Time 返回 ULID 中记录的生成时间（毫秒精度）
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(getMillis(u[:]))
+}
+
+// MarshalText 实现 encoding.TextMarshaler
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (u *ULID) UnmarshalText(text []byte) error {
+	res, err := ParseULID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = res
+	return nil
+}
+
+// putMillis 将毫秒级时间戳的低 48 位按大端序写入 b 的前 6 个字节
+func putMillis(b []byte, ms int64) {
+	_ = b[5]
+	b[0] = byte(ms >> 40)
+	b[1] = byte(ms >> 32)
+	b[2] = byte(ms >> 24)
+	b[3] = byte(ms >> 16)
+	b[4] = byte(ms >> 8)
+	b[5] = byte(ms)
+}
+
+// getMillis 读取 b 的前 6 个字节表示的毫秒级时间戳
+func getMillis(b []byte) int64 {
+	_ = b[5]
+	return int64(b[0])<<40 | int64(b[1])<<32 | int64(b[2])<<24 |
+		int64(b[3])<<16 | int64(b[4])<<8 | int64(b[5])
+}
@@ -0,0 +1,155 @@
+/**
+ * Description：
+ * FileName：ulid_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 18:51:40
+ * Remark：
+ */
+
+package idx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestULIDString(t *testing.T) {
+	testCases := []struct {
+		name string
+		ulid ULID
+		want string
+	}{
+		{
+			name: "zero",
+			want: "00000000000000000000000000",
+		},
+		{
+			name: "max",
+			ulid: ULID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			want: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		},
+		{
+			// ULID 规范中的示例
+			name: "spec",
+			ulid: ULID{0x01, 0x56, 0x3e, 0x3a, 0xb5, 0xd3, 0xd6, 0x76, 0x4c, 0x61, 0xef, 0xb9, 0x93, 0x02, 0xbd, 0x5b},
+			want: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.ulid.String())
+			res, err := ParseULID(tc.want)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ulid, res)
+		})
+	}
+}
+
+func TestParseULID(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{
+			name:  "lower case",
+			input: "01arz3ndektsv4rrffq69g5fav",
+		},
+		{
+			name:    "invalid length",
+			input:   "01ARZ3NDEK",
+			wantErr: errInvalidULID,
+		},
+		{
+			name:    "overflow",
+			input:   "8ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+			wantErr: errInvalidULID,
+		},
+		{
+			name:    "invalid char",
+			input:   "01ARZ3NDEKTSV4RRFFQ69G5FAU",
+			wantErr: errInvalidULID,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ParseULID(tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, strings.ToUpper(tc.input), res.String())
+		})
+	}
+}
+
+func TestNewULID(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	u := NewULID()
+	assert.False(t, u.Time().Before(before))
+	assert.Len(t, u.String(), 26)
+	assert.NotEqual(t, u, NewULID())
+}
+
+func TestULIDGeneratorZeroValue(t *testing.T) {
+	var g ULIDGenerator
+	before := time.Now().UnixMilli()
+	first, err := g.Next()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, first.Time().UnixMilli(), before)
+
+	next, err := g.Next()
+	require.NoError(t, err)
+	assert.Less(t, first.String(), next.String())
+}
+
+func TestULIDGenerator(t *testing.T) {
+	now := time.UnixMilli(1760000000000)
+	g := NewULIDGenerator()
+	g.now = func() time.Time {
+		return now
+	}
+
+	first, err := g.Next()
+	require.NoError(t, err)
+	assert.Equal(t, now, first.Time())
+
+	// 同一毫秒内严格递增
+	prev := first
+	for i := 0; i < 100; i++ {
+		next, err := g.Next()
+		require.NoError(t, err)
+		assert.Less(t, prev.String(), next.String())
+		prev = next
+	}
+
+	// 时钟回拨仍然递增
+	now = now.Add(-time.Second)
+	next, err := g.Next()
+	require.NoError(t, err)
+	assert.Less(t, prev.String(), next.String())
+
+	// 随机部分溢出
+	for i := 6; i < len(g.last); i++ {
+		g.last[i] = 0xff
+	}
+	g.last[len(g.last)-1] = 0xfe
+	prev, err = g.Next()
+	require.NoError(t, err)
+	_, err = g.Next()
+	assert.Equal(t, errULIDOverflow, err)
+
+	// 溢出之后同一毫秒内一直返回错误，不会生成比之前更小的 ULID
+	_, err = g.Next()
+	assert.Equal(t, errULIDOverflow, err)
+	assert.Equal(t, prev, g.last)
+
+	// 进入新的毫秒后恢复
+	now = now.Add(time.Hour)
+	next, err = g.Next()
+	require.NoError(t, err)
+	assert.Equal(t, now, next.Time())
+}
@@ -0,0 +1,134 @@
+/**
+ * Description：
+ * FileName：uuid.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 18:05:42
+ * Remark：
+ */
+
+package idx
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidUUID = errors.New("echo: 无效的 UUID")
+)
+
+// UUID 符合 RFC 9562 的 128 位通用唯一识别码
+type UUID [16]byte
+
+// NewUUIDv4 生成一个版本 4 的 UUID
+// 除版本号和变体外的 122 位全部来自 crypto/rand
+func NewUUIDv4() UUID {
+	var u UUID
+	readRandom(u[:])
+	u.setVersion(4)
+	return u
+}
+
+// NewUUIDv7 生成一个版本 7 的 UUID
+// 高 48 位为毫秒级 Unix 时间戳，其余部分来自 crypto/rand
+// 不同毫秒生成的 UUID 按字典序（以及字符串顺序）递增，适合作为数据库主键
+func NewUUIDv7() UUID {
+	return newUUIDv7(time.Now())
+}
+
+func newUUIDv7(now time.Time) UUID {
+	var u UUID
+	readRandom(u[6:])
+	putMillis(u[:], now.UnixMilli())
+	u.setVersion(7)
+	return u
+}
+
+// ParseUUID 解析 UUID 字符串
+// 支持标准格式 xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx 和不带连字符的 32 位十六进制格式，不区分大小写
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	switch len(s) {
+	case 32:
+		if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+			return UUID{}, errInvalidUUID
+		}
+		return u, nil
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return UUID{}, errInvalidUUID
+		}
+		// 每一段在字符串中的起始位置
+		j := 0
+		for _, i := range [16]int{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34} {
+			if _, err := hex.Decode(u[j:j+1], []byte(s[i:i+2])); err != nil {
+				return UUID{}, errInvalidUUID
+			}
+			j++
+		}
+		return u, nil
+	default:
+		return UUID{}, errInvalidUUID
+	}
+}
+
+// String 返回标准格式 xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx 的小写字符串
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Version 返回 UUID 的版本号
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Time 返回版本 7 的 UUID 中记录的生成时间（毫秒精度）
+// 其他版本返回 false
+func (u UUID) Time() (time.Time, bool) {
+	if u.Version() != 7 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(getMillis(u[:])), true
+}
+
+// MarshalText 实现 encoding.TextMarshaler，序列化为标准格式的字符串
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler，支持的格式与 ParseUUID 相同
+func (u *UUID) UnmarshalText(text []byte) error {
+	res, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = res
+	return nil
+}
+
+// setVersion 设置版本号以及 RFC 9562 规定的变体位 10xx
+func (u *UUID) setVersion(version byte) {
+	u[6] = (u[6] & 0x0f) | version<<4
+	u[8] = (u[8] & 0x3f) | 0x80
+}
+
+// readRandom 使用 crypto/rand 填充 UUID、ULID 的随机部分
+func readRandom(b []byte) {
+	if _, err := rand.Read(b); err != nil {
+		// ID 的唯一性完全依赖随机部分，读取失败后继续生成会得到全零或者重复的 ID，
+		// 因此直接 panic，NewUUIDv4、NewULID 等函数也就不需要返回 error
+		panic("echo: 生成 ID 时读取 crypto/rand 失败: " + err.Error())
+	}
+}
@@ -0,0 +1,115 @@
+/**
+ * Description：
+ * FileName：uuid_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 18:22:09
+ * Remark：
+ */
+
+package idx
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestNewUUIDv4(t *testing.T) {
+	seen := make(map[UUID]struct{}, 100)
+	for i := 0; i < 100; i++ {
+		u := NewUUIDv4()
+		assert.Equal(t, 4, u.Version())
+		assert.Regexp(t, uuidPattern, u.String())
+		assert.Equal(t, byte('4'), u.String()[14])
+		seen[u] = struct{}{}
+	}
+	assert.Len(t, seen, 100)
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	now := time.UnixMilli(1760000000123)
+	u := newUUIDv7(now)
+	assert.Equal(t, 7, u.Version())
+	assert.Regexp(t, uuidPattern, u.String())
+	ts, ok := u.Time()
+	assert.True(t, ok)
+	assert.Equal(t, now, ts)
+
+	// 不同毫秒生成的 UUID 字符串递增
+	later := newUUIDv7(now.Add(time.Millisecond))
+	assert.Less(t, u.String(), later.String())
+
+	_, ok = NewUUIDv4().Time()
+	assert.False(t, ok)
+}
+
+func TestParseUUID(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "standard",
+			input: "0192f5c4-6a1e-7cc2-9b3a-1f2e3d4c5b6a",
+			want:  "0192f5c4-6a1e-7cc2-9b3a-1f2e3d4c5b6a",
+		},
+		{
+			name:  "upper case",
+			input: "0192F5C4-6A1E-7CC2-9B3A-1F2E3D4C5B6A",
+			want:  "0192f5c4-6a1e-7cc2-9b3a-1f2e3d4c5b6a",
+		},
+		{
+			name:  "without hyphen",
+			input: "0192f5c46a1e7cc29b3a1f2e3d4c5b6a",
+			want:  "0192f5c4-6a1e-7cc2-9b3a-1f2e3d4c5b6a",
+		},
+		{
+			name:    "invalid length",
+			input:   "0192f5c4-6a1e-7cc2-9b3a",
+			wantErr: errInvalidUUID,
+		},
+		{
+			name:    "invalid hyphen",
+			input:   "0192f5c4_6a1e-7cc2-9b3a-1f2e3d4c5b6a",
+			wantErr: errInvalidUUID,
+		},
+		{
+			name:    "invalid hex",
+			input:   "0192f5c4-6a1e-7cc2-9b3a-1f2e3d4c5b6z",
+			wantErr: errInvalidUUID,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := ParseUUID(tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, u.String())
+		})
+	}
+}
+
+func TestUUIDJSON(t *testing.T) {
+	type entity struct {
+		ID UUID `json:"id"`
+	}
+	src := entity{ID: NewUUIDv4()}
+	data, err := json.Marshal(src)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"`+src.ID.String()+`"}`, string(data))
+
+	var dst entity
+	require.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, src, dst)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"id":"abc"}`), &dst))
+}
@@ -37,3 +37,98 @@ func NewErrInvalidMaxIntervalValue(maxInterval, initialInterval time.Duration) e
 func NewErrRetryExhausted(lastErr error) error {
 	return fmt.Errorf("echo: 超过最大重试次数，业务返回的最后一个 error %w", lastErr)
 }
+
+// NewErrTaskPanic 创建一个代表任务执行时发生 panic 的错误
+func NewErrTaskPanic(p any) error {
+	return fmt.Errorf("echo: 任务执行发生 panic: %v", p)
+}
+
+// NewErrOverflow 创建一个代表数值计算溢出的错误
+func NewErrOverflow(index int, val any) error {
+	return fmt.Errorf("echo: 数值计算溢出，下标 %d, 值 %v", index, val)
+}
+
+// NewErrClockMovedBackwards 创建一个代表时钟回拨的错误
+func NewErrClockMovedBackwards(last, now int64) error {
+	return fmt.Errorf("echo: 检测到时钟回拨，上次生成时间 %d ms, 当前时间 %d ms", last, now)
+}
+
+// NewErrInvalidWorkerID 创建一个代表机器 ID 超出范围的错误
+func NewErrInvalidWorkerID(workerID, maxWorkerID int64) error {
+	return fmt.Errorf("echo: 无效的机器 ID %d, 取值范围 [0, %d]", workerID, maxWorkerID)
+}
+
+// NewErrUnsafeMutation 创建一个代表零拷贝转换后共享内存被修改的错误
+func NewErrUnsafeMutation(location string) error {
+	return fmt.Errorf("echo: 零拷贝转换后的共享内存被修改，转换位置 %s", location)
+}
+
+// NewErrInvalidTemplate 创建一个代表模板语法错误的错误
+func NewErrInvalidTemplate(format string, pos int) error {
+	return fmt.Errorf("echo: 无效的模板 %q, 位置 %d", format, pos)
+}
+
+// NewErrKeyNotFound 创建一个代表找不到键的错误
+func NewErrKeyNotFound(key string) error {
+	return fmt.Errorf("echo: 找不到键 %s", key)
+}
+
+// NewErrOptionRequired 创建一个代表必填配置项缺失的错误
+func NewErrOptionRequired(name string) error {
+	return fmt.Errorf("echo: 缺少必填的配置项 %s", name)
+}
+
+// NewErrOptionOutOfRange 创建一个代表配置项超出取值范围的错误
+func NewErrOptionOutOfRange(name string, val, minVal, maxVal any) error {
+	return fmt.Errorf("echo: 配置项 %s 超出范围，取值范围 [%v, %v], 实际值 %v", name, minVal, maxVal, val)
+}
+
+// NewErrOptionNotOneOf 创建一个代表配置项不在可选值中的错误
+func NewErrOptionNotOneOf(name string, val any, values any) error {
+	return fmt.Errorf("echo: 配置项 %s 的值 %v 不在可选值 %v 中", name, val, values)
+}
+
+// NewErrOptionConflict 创建一个代表互斥的配置项被同时设置的错误
+func NewErrOptionConflict(names []string) error {
+	return fmt.Errorf("echo: 配置项 %v 互斥，最多只能设置一个", names)
+}
+
+// NewErrOptionLoad 创建一个代表从配置源加载配置项失败的错误
+func NewErrOptionLoad(source string, key string, err error) error {
+	return fmt.Errorf("echo: 从 %s 加载配置项 %s 失败: %w", source, key, err)
+}
+
+// NewErrUnknownOption 创建一个代表找不到配置项的错误
+func NewErrUnknownOption(key string) error {
+	return fmt.Errorf("echo: 未知的配置项 %s", key)
+}
+
+// NewErrOptionParse 创建一个代表配置源解析失败的错误
+func NewErrOptionParse(source string, err error) error {
+	return fmt.Errorf("echo: 解析配置 %s 失败: %w", source, err)
+}
+
+// NewErrUnsupportedFormat 创建一个代表不支持的文件格式的错误
+func NewErrUnsupportedFormat(path string) error {
+	return fmt.Errorf("echo: 不支持的文件格式 %s", path)
+}
+
+// NewErrCopyField 创建一个代表复制字段失败的错误
+func NewErrCopyField(field string, err error) error {
+	return fmt.Errorf("echo: 复制字段 %s 失败: %w", field, err)
+}
+
+// NewErrFieldNotFound 创建一个代表找不到可以设置的字段的错误
+func NewErrFieldNotFound(path string) error {
+	return fmt.Errorf("echo: 找不到可以设置的字段 %s", path)
+}
+
+// NewErrMethodNotFound 创建一个代表找不到方法的错误
+func NewErrMethodNotFound(typ string, name string) error {
+	return fmt.Errorf("echo: 类型 %s 没有导出的方法 %s", typ, name)
+}
+
+// NewErrInvalidArgCount 创建一个代表参数数量不匹配的错误
+func NewErrInvalidArgCount(name string, want string, got int) error {
+	return fmt.Errorf("echo: 方法 %s 的参数数量不匹配，预期 %s 个，实际 %d 个", name, want, got)
+}
@@ -0,0 +1,95 @@
+/**
+ * Description：
+ * FileName：reflectutil.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 10:12:36
+ * Remark：
+ */
+
+// Package reflectutil 存放 bean、bean/copier、bean/option 以及 reflectx 共用的反射辅助函数，
+// 保证数字转换的溢出和符号规则、字段路径的格式只在这里定义
+package reflectutil
+
+import (
+	"math"
+	"reflect"
+)
+
+// IsBasic 是否为布尔、字符串或者数字（包括复数）类型
+func IsBasic(kind reflect.Kind) bool {
+	return kind == reflect.Bool || kind == reflect.String || IsNumber(kind) || kind == reflect.Complex64 || kind == reflect.Complex128
+}
+
+// IsNumber 是否为整数或者浮点数类型，不包括 uintptr 和复数
+func IsNumber(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64 && kind != reflect.Uintptr
+}
+
+// IsUnsigned 是否为无符号整数类型，不包括 uintptr
+func IsUnsigned(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uint64
+}
+
+// IsNegative v 是否为负数，v 不是有符号整数或者浮点数时返回 false
+func IsNegative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
+// ConvertNumber 将数字 v 转换为数字类型 typ
+// 任意一方不是数字时 ok 为 false，其余规则取决于 typ：
+//   - 整数：负数转换为无符号数，或者发生溢出、截断（例如 1.5 => 1）时 ok 为 false
+//   - 浮点数：允许精度损失（例如 float64 的 0.1 => float32），只有超出 typ 表示范围的有限值 ok 为 false，±Inf 和 NaN 原样转换
+func ConvertNumber(v reflect.Value, typ reflect.Type) (res reflect.Value, ok bool) {
+	if !IsNumber(v.Kind()) || !IsNumber(typ.Kind()) {
+		return reflect.Value{}, false
+	}
+	if typ.Kind() == reflect.Float32 || typ.Kind() == reflect.Float64 {
+		if typ.Kind() == reflect.Float32 && v.CanFloat() {
+			if f := v.Float(); !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+				return reflect.Value{}, false
+			}
+		}
+		return v.Convert(typ), true
+	}
+	if IsUnsigned(typ.Kind()) && IsNegative(v) {
+		return reflect.Value{}, false
+	}
+	res = v.Convert(typ)
+	// 转换后再转回来不相等，说明发生了溢出或者截断
+	// 无符号数转换为有符号数之后为负数时也是溢出，例如 uint64 的最大值 => int64 的 -1，转回来仍然相等
+	if !res.Convert(v.Type()).Equal(v) || (IsUnsigned(v.Kind()) && IsNegative(res)) {
+		return reflect.Value{}, false
+	}
+	return res, true
+}
+
+// JoinPath 使用 . 连接字段路径，path 为空时返回 name
+func JoinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// IsPromoted 有导出字段的嵌入结构体（非指针），它的字段会被提升
+func IsPromoted(f reflect.StructField) bool {
+	return f.Anonymous && f.Type.Kind() == reflect.Struct && HasExported(f.Type)
+}
+
+// HasExported 结构体中是否有导出字段，包括嵌入结构体中被提升的字段
+func HasExported(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.IsExported() || IsPromoted(f) {
+			return true
+		}
+	}
+	return false
+}
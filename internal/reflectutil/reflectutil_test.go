@@ -0,0 +1,80 @@
+/**
+ * Description：
+ * FileName：reflectutil_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 10:25:08
+ * Remark：
+ */
+
+package reflectutil
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestConvertNumber(t *testing.T) {
+	testCases := []struct {
+		name   string
+		value  any
+		typ    reflect.Type
+		want   any
+		wantOk bool
+	}{
+		{name: "int to int8", value: 127, typ: reflect.TypeOf(int8(0)), want: int8(127), wantOk: true},
+		{name: "int8 overflow", value: 128, typ: reflect.TypeOf(int8(0))},
+		{name: "negative to uint", value: -1, typ: reflect.TypeOf(uint(0))},
+		{name: "negative float to uint", value: -0.5, typ: reflect.TypeOf(uint(0))},
+		{name: "uint64 overflow int64", value: uint64(math.MaxUint64), typ: reflect.TypeOf(int64(0))},
+		{name: "float truncate", value: 1.5, typ: reflect.TypeOf(0)},
+		{name: "float to int", value: 2.0, typ: reflect.TypeOf(0), want: 2, wantOk: true},
+		{name: "int to float", value: 3, typ: reflect.TypeOf(0.0), want: 3.0, wantOk: true},
+		{name: "float64 to float32 rounding", value: 0.1, typ: reflect.TypeOf(float32(0)), want: float32(0.1), wantOk: true},
+		{name: "float64 to float32 max", value: -math.MaxFloat32, typ: reflect.TypeOf(float32(0)), want: float32(-math.MaxFloat32), wantOk: true},
+		{name: "float64 to float32 overflow", value: 1e39, typ: reflect.TypeOf(float32(0))},
+		{name: "float64 to float32 inf", value: math.Inf(-1), typ: reflect.TypeOf(float32(0)), want: float32(math.Inf(-1)), wantOk: true},
+		{name: "int64 to float32", value: int64(1<<24 + 1), typ: reflect.TypeOf(float32(0)), want: float32(1 << 24), wantOk: true},
+		{name: "nan to int", value: math.NaN(), typ: reflect.TypeOf(0)},
+		{name: "not number", value: "1", typ: reflect.TypeOf(0)},
+		{name: "uintptr", value: uintptr(1), typ: reflect.TypeOf(0)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := ConvertNumber(reflect.ValueOf(tc.value), tc.typ)
+			assert.Equal(t, tc.wantOk, ok)
+			if ok {
+				assert.Equal(t, tc.want, res.Interface())
+			}
+		})
+	}
+}
+
+func TestConvertNumberNaN(t *testing.T) {
+	res, ok := ConvertNumber(reflect.ValueOf(math.NaN()), reflect.TypeOf(float32(0)))
+	assert.True(t, ok)
+	assert.True(t, math.IsNaN(res.Float()))
+}
+
+func TestJoinPath(t *testing.T) {
+	assert.Equal(t, "Name", JoinPath("", "Name"))
+	assert.Equal(t, "User.Name", JoinPath("User", "Name"))
+}
+
+func TestHasExported(t *testing.T) {
+	type inner struct{ Name string }
+	type private struct{ name string }
+	type embedded struct {
+		inner
+		age int
+	}
+	type embeddedPrivate struct {
+		private
+	}
+	assert.True(t, HasExported(reflect.TypeOf(inner{})))
+	assert.False(t, HasExported(reflect.TypeOf(private{})))
+	assert.True(t, HasExported(reflect.TypeOf(embedded{})))
+	assert.False(t, HasExported(reflect.TypeOf(embeddedPrivate{})))
+	assert.False(t, HasExported(reflect.TypeOf(struct{}{})))
+}
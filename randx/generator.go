@@ -0,0 +1,204 @@
+/**
+ * Description：
+ * FileName：generator.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 16:52:08
+ * Remark：
+ */
+
+package randx
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"math/rand/v2"
+	"sync"
+)
+
+// defaultGenerator 包级别函数使用的生成器，基于 crypto/rand
+var defaultGenerator = NewGenerator()
+
+// generatorPool 缓存非并发安全的 Generator，参考 AcquireGenerator
+var generatorPool = sync.Pool{
+	New: func() any {
+		g := &Generator{
+			src: newCryptoSeededSource(),
+		}
+		g.r = rand.New(g.src)
+		return g
+	},
+}
+
+// Generator 随机数生成器
+// 不同的 Generator 可以使用不同的随机源，默认使用 crypto/rand
+// 通过 NewGenerator 创建的 Generator 都是并发安全的
+type Generator struct {
+	src source
+	r   *rand.Rand
+}
+
+// NewGenerator 创建一个随机数生成器
+// 默认使用 crypto/rand 作为随机源，可以直接用于安全敏感的场景
+func NewGenerator(opts ...option.Option[Generator]) *Generator {
+	g := &Generator{
+		src: cryptoSource{},
+	}
+	option.Apply(g, opts...)
+	g.r = rand.New(g.src)
+	return g
+}
+
+// AcquireGenerator 从池中获取一个 Generator
+// 该 Generator 使用 ChaCha8 作为随机源，256 位的种子全部来自 crypto/rand，没有加锁，只能在当前 goroutine 中使用
+// ChaCha8 是密码学安全的生成器，种子不可预测时同样可以用于验证码、令牌等安全敏感的场景
+// 适用于高并发下大量生成随机数、希望避免锁竞争的场景，使用完毕后应调用 ReleaseGenerator 归还
+func AcquireGenerator() *Generator {
+	return generatorPool.Get().(*Generator)
+}
+
+// ReleaseGenerator 将 AcquireGenerator 获取的 Generator 归还到池中
+// 归还后不能再使用该 Generator
+func ReleaseGenerator(g *Generator) {
+	generatorPool.Put(g)
+}
+
+// WithCrypto 使用 crypto/rand 作为随机源
+// 生成的结果不可预测，适用于验证码、令牌、密码等安全敏感的场景
+func WithCrypto() option.Option[Generator] {
+	return func(g *Generator) {
+		g.src = cryptoSource{}
+	}
+}
+
+// WithMathRand 使用 math/rand/v2 的全局随机源
+// 速度更快，但不适用于安全敏感的场景
+func WithMathRand() option.Option[Generator] {
+	return func(g *Generator) {
+		g.src = mathSource{}
+	}
+}
+
+// WithSeed 使用固定种子的 ChaCha8 作为随机源
+// 相同种子的 Generator 以相同的顺序调用相同的方法，总是得到相同的结果，适用于需要复现结果的测试
+// 种子是可以被猜到的，不能用于安全敏感的场景
+func WithSeed(seed uint64) option.Option[Generator] {
+	return func(g *Generator) {
+		g.src = &lockedSource{src: newSeededSource(seed)}
+	}
+}
+
+// WithSource 使用自定义的随机源
+// src 不需要是并发安全的，Generator 会使用互斥锁保护它
+func WithSource(src rand.Source) option.Option[Generator] {
+	return func(g *Generator) {
+		g.src = &lockedSource{src: src}
+	}
+}
+
+// RandCode 根据传入的长度和类型生成随机字符串
+// 参数校验规则与包级别的 RandCode 相同
+func (g *Generator) RandCode(length int, typ Type, opts ...option.Option[CodeConfig]) (string, error) {
+	if length < 0 {
+		return "", errLengthLessThanZero
+	}
+	if length == 0 {
+		return "", nil
+	}
+	if typ > TypeMixed {
+		return "", errTypeNotSupported
+	}
+	var cfg CodeConfig
+	option.Apply(&cfg, opts...)
+
+	charset := ""
+	classes := make([]string, 0, len(typeCharsetPairs))
+	for _, p := range typeCharsetPairs {
+		if (typ & p.Key) == p.Key {
+			class := cfg.apply(p.Value)
+			charset += class
+			classes = append(classes, class)
+		}
+	}
+	if !cfg.requireEach {
+		return g.RandStrByCharset(length, charset)
+	}
+
+	if len(classes) == 0 {
+		return "", errTypeNotSupported
+	}
+	if length < len(classes) {
+		return "", errLengthTooShort
+	}
+	// 先从每种类型中各取一个字符，剩余部分从完整字符集中生成，最后整体打乱
+	res := make([]byte, 0, length)
+	for _, class := range classes {
+		res = append(res, class[g.Int(len(class))])
+	}
+	res = append(res, generate(g.src, charset, length-len(classes), getFirstMask(len(charset)))...)
+	g.Shuffle(len(res), func(i, j int) {
+		res[i], res[j] = res[j], res[i]
+	})
+	return string(res), nil
+}
+
+// RandStrByCharset 根据传入的长度和字符集生成随机字符串
+// 参数校验规则与包级别的 RandStrByCharset 相同
+func (g *Generator) RandStrByCharset(length int, charset string) (string, error) {
+	if length < 0 {
+		return "", errLengthLessThanZero
+	}
+	if length == 0 {
+		return "", nil
+	}
+	charsetSize := len(charset)
+	if charsetSize == 0 {
+		return "", errTypeNotSupported
+	}
+	return generate(g.src, charset, length, getFirstMask(charsetSize)), nil
+}
+
+// Int 返回一个 [0, n) 范围内的随机整数
+// n <= 0 时会 panic
+func (g *Generator) Int(n int) int {
+	return g.r.IntN(n)
+}
+
+// Float 返回一个 [0.0, 1.0) 范围内的随机浮点数
+func (g *Generator) Float() float64 {
+	return g.r.Float64()
+}
+
+// Shuffle 使用 Fisher-Yates 算法随机打乱 n 个元素的顺序
+// swap 用于交换下标为 i 和 j 的两个元素
+// n < 0 时会 panic
+func (g *Generator) Shuffle(n int, swap func(i, j int)) {
+	g.r.Shuffle(n, swap)
+}
+
+// Sample 从 [0, n) 中不重复地随机选取 k 个下标，返回顺序也是随机的
+// 只需要 O(k) 的时间和空间，适用于 n 很大而 k 很小的场景
+// k > n 时返回 [0, n) 的一个随机排列，k <= 0 时返回空切片
+func (g *Generator) Sample(n, k int) []int {
+	if k > n {
+		k = n
+	}
+	if k <= 0 {
+		return make([]int, 0)
+	}
+
+	// 虚拟的 Fisher-Yates 洗牌：只记录被交换过的位置，未记录的位置 i 上的值就是 i
+	swapped := make(map[int]int, k)
+	get := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+		return i
+	}
+	res := make([]int, k)
+	for i := 0; i < k; i++ {
+		j := i + g.r.IntN(n-i)
+		res[i] = get(j)
+		// 位置 i 之后不会再被访问，只需要把原来 i 上的值换到 j 上
+		swapped[j] = get(i)
+	}
+	return res
+}
@@ -0,0 +1,326 @@
+/**
+ * Description：
+ * FileName：generator_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 17:10:36
+ * Remark：
+ */
+
+package randx
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/stretchr/testify/assert"
+	"math/rand/v2"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestNewGenerator(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    []option.Option[Generator]
+		wantSrc source
+	}{
+		{
+			name:    "default crypto",
+			wantSrc: cryptoSource{},
+		},
+		{
+			name:    "math rand",
+			opts:    []option.Option[Generator]{WithMathRand()},
+			wantSrc: mathSource{},
+		},
+		{
+			name:    "seed",
+			opts:    []option.Option[Generator]{WithSeed(42)},
+			wantSrc: &lockedSource{src: newSeededSource(42)},
+		},
+		{
+			name:    "custom source",
+			opts:    []option.Option[Generator]{WithSource(rand.NewPCG(1, 2))},
+			wantSrc: &lockedSource{src: rand.NewPCG(1, 2)},
+		},
+		{
+			name:    "last option wins",
+			opts:    []option.Option[Generator]{WithMathRand(), WithCrypto()},
+			wantSrc: cryptoSource{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGenerator(tc.opts...)
+			assert.Equal(t, tc.wantSrc, g.src)
+		})
+	}
+	// 包级别函数默认使用 crypto/rand
+	assert.Equal(t, cryptoSource{}, defaultGenerator.src)
+}
+
+func TestGeneratorRandCode(t *testing.T) {
+	testCases := []struct {
+		name      string
+		g         *Generator
+		length    int
+		typ       Type
+		wantMatch string
+		wantErr   error
+	}{
+		{
+			name:      "crypto digit",
+			g:         NewGenerator(WithCrypto()),
+			length:    100,
+			typ:       TypeDigit,
+			wantMatch: "^[0-9]+$",
+		},
+		{
+			name:      "math rand letters",
+			g:         NewGenerator(WithMathRand()),
+			length:    100,
+			typ:       TypeLowerCase | TypeUpperCase,
+			wantMatch: "^[a-zA-Z]+$",
+		},
+		{
+			name:    "type not supported",
+			g:       NewGenerator(),
+			length:  10,
+			typ:     TypeMixed + 1,
+			wantErr: errTypeNotSupported,
+		},
+		{
+			name:    "length less than 0",
+			g:       NewGenerator(),
+			length:  -1,
+			typ:     TypeDigit,
+			wantErr: errLengthLessThanZero,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, err := tc.g.RandCode(tc.length, tc.typ)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Len(t, code, tc.length)
+			assert.Regexp(t, regexp.MustCompile(tc.wantMatch), code)
+		})
+	}
+}
+
+func TestGeneratorRandCodeWithOptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		length    int
+		typ       Type
+		opts      []option.Option[CodeConfig]
+		wantMatch string
+		wantErr   error
+	}{
+		{
+			name:      "each type",
+			length:    4,
+			typ:       TypeMixed,
+			opts:      []option.Option[CodeConfig]{WithEachType()},
+			wantMatch: `^[\s\S]{4}$`,
+		},
+		{
+			name:    "each type length too short",
+			length:  2,
+			typ:     TypeDigit | TypeLowerCase | TypeUpperCase,
+			opts:    []option.Option[CodeConfig]{WithEachType()},
+			wantErr: errLengthTooShort,
+		},
+		{
+			name:    "each type without type",
+			length:  2,
+			typ:     0,
+			opts:    []option.Option[CodeConfig]{WithEachType()},
+			wantErr: errTypeNotSupported,
+		},
+		{
+			name:      "without ambiguous",
+			length:    200,
+			typ:       TypeDigit | TypeLowerCase | TypeUpperCase,
+			opts:      []option.Option[CodeConfig]{WithoutAmbiguous(), WithEachType()},
+			wantMatch: `^[2-9a-km-zA-HJ-NP-Z]{200}$`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGenerator(WithSeed(1))
+			// 多次生成以覆盖随机性
+			for i := 0; i < 100; i++ {
+				code, err := g.RandCode(tc.length, tc.typ, tc.opts...)
+				assert.Equal(t, tc.wantErr, err)
+				if err != nil {
+					return
+				}
+				assert.Regexp(t, tc.wantMatch, code)
+				// 每种类型都至少出现一次
+				assert.NoError(t, Policy{Required: tc.typ}.Validate(code), code)
+			}
+		})
+	}
+}
+
+func TestGeneratorSeed(t *testing.T) {
+	run := func(g *Generator) []any {
+		code, err := g.RandCode(16, TypeMixed)
+		assert.NoError(t, err)
+		perm := []int{1, 2, 3, 4, 5}
+		g.Shuffle(len(perm), func(i, j int) {
+			perm[i], perm[j] = perm[j], perm[i]
+		})
+		return []any{code, g.Int(1000), g.Float(), perm, g.Sample(100, 5)}
+	}
+	// 相同种子得到相同的结果
+	assert.Equal(t, run(NewGenerator(WithSeed(42))), run(NewGenerator(WithSeed(42))))
+	// 不同种子得到不同的结果
+	assert.NotEqual(t, run(NewGenerator(WithSeed(42))), run(NewGenerator(WithSeed(43))))
+}
+
+func TestGeneratorIntFloat(t *testing.T) {
+	g := NewGenerator()
+	for i := 0; i < 1000; i++ {
+		v := g.Int(10)
+		assert.True(t, v >= 0 && v < 10)
+		f := g.Float()
+		assert.True(t, f >= 0 && f < 1)
+	}
+	assert.Panics(t, func() {
+		g.Int(0)
+	})
+}
+
+func TestGeneratorSample(t *testing.T) {
+	testCases := []struct {
+		name    string
+		n       int
+		k       int
+		wantLen int
+	}{
+		{
+			name:    "k 0",
+			n:       10,
+			k:       0,
+			wantLen: 0,
+		},
+		{
+			name:    "k less than n",
+			n:       1000000,
+			k:       10,
+			wantLen: 10,
+		},
+		{
+			name:    "k equals n",
+			n:       10,
+			k:       10,
+			wantLen: 10,
+		},
+		{
+			name:    "k greater than n",
+			n:       5,
+			k:       10,
+			wantLen: 5,
+		},
+	}
+	g := NewGenerator(WithSeed(1))
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := g.Sample(tc.n, tc.k)
+			assert.Len(t, res, tc.wantLen)
+			seen := make(map[int]struct{}, len(res))
+			for _, v := range res {
+				assert.True(t, v >= 0 && v < tc.n)
+				seen[v] = struct{}{}
+			}
+			// 不会重复
+			assert.Len(t, seen, tc.wantLen)
+		})
+	}
+}
+
+func TestGeneratorSampleIsPermutation(t *testing.T) {
+	res := NewGenerator(WithSeed(7)).Sample(8, 8)
+	sort.Ints(res)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, res)
+}
+
+func TestGeneratorConcurrent(t *testing.T) {
+	g := NewGenerator(WithSeed(42))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, err := g.RandCode(8, TypeDigit)
+				assert.NoError(t, err)
+				g.Int(100)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAcquireGenerator(t *testing.T) {
+	g := AcquireGenerator()
+	code, err := g.RandCode(10, TypeDigit)
+	assert.NoError(t, err)
+	assert.Len(t, code, 10)
+	ReleaseGenerator(g)
+}
+
+func TestCryptoSource(t *testing.T) {
+	src := cryptoSource{}
+	seen := make(map[uint64]struct{}, 1000)
+	for i := 0; i < 1000; i++ {
+		seen[src.Uint64()] = struct{}{}
+	}
+	// 64 位随机数在 1000 次内几乎不可能重复
+	assert.Len(t, seen, 1000)
+}
+
+func TestCryptoSeededSource(t *testing.T) {
+	seen := make(map[uint64]struct{}, 100)
+	for i := 0; i < 100; i++ {
+		seen[newCryptoSeededSource().Uint64()] = struct{}{}
+	}
+	// 每个随机源的种子都不同，第一个输出几乎不可能重复
+	assert.Len(t, seen, 100)
+}
+
+func BenchmarkGenerator_RandCode(b *testing.B) {
+	b.Run("crypto", func(b *testing.B) {
+		g := NewGenerator(WithCrypto())
+		for i := 0; i < b.N; i++ {
+			_, _ = g.RandCode(6, TypeDigit)
+		}
+	})
+	b.Run("math rand", func(b *testing.B) {
+		g := NewGenerator(WithMathRand())
+		for i := 0; i < b.N; i++ {
+			_, _ = g.RandCode(6, TypeDigit)
+		}
+	})
+	b.Run("seed", func(b *testing.B) {
+		g := NewGenerator(WithSeed(42))
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = g.RandCode(6, TypeDigit)
+			}
+		})
+	})
+	b.Run("pool", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				g := AcquireGenerator()
+				_, _ = g.RandCode(6, TypeDigit)
+				ReleaseGenerator(g)
+			}
+		})
+	})
+}
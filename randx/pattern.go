@@ -0,0 +1,391 @@
+/**
+ * Description：
+ * FileName：pattern.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 21:15:26
+ * Remark：
+ */
+
+package randx
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxPatternRepeat 模式中单个量词允许的最大重复次数，避免误写导致生成超长字符串
+	maxPatternRepeat = 1024
+
+	pronounceableConsonants = "bcdfghjklmnprstvwxz"
+	pronounceableVowels     = "aeiou"
+)
+
+var (
+	errInvalidTemplate = errors.New("echo:无效的模板")
+	errInvalidPattern  = errors.New("echo:无效的模式")
+
+	// templatePlaceholders 模板中的占位符及其对应的字符集
+	templatePlaceholders = map[byte]string{
+		'9': CharsetDigit,
+		'a': CharsetLowerCase,
+		'A': CharsetUpperCase,
+		'*': CharsetDigit + CharsetLowerCase + CharsetUpperCase,
+		'!': CharsetSpecial,
+	}
+
+	// patternEscapes 模式中的转义字符类及其对应的字符集
+	patternEscapes = map[byte]string{
+		'd': CharsetDigit,
+		'w': CharsetDigit + CharsetLowerCase + CharsetUpperCase + "_",
+	}
+)
+
+// segment 模板或模式编译后的一个位置
+// literal 不为空时原样输出，否则从 charset 中随机选择一个字符
+type segment struct {
+	literal string
+	charset string
+	// optional 超出量词最小次数的重复，WithEachType 不会依赖这些位置
+	optional bool
+}
+
+// RandByTemplate 根据模板生成随机字符串
+// 模板中的占位符：9 数字、a 小写字母、A 大写字母、* 数字或字母、! 特殊字符
+// 其余字符原样输出，使用 \ 可以转义占位符，例如 "AAA-999-aaa" 可能生成 "QWE-073-kfz"
+// 默认不保证每种类型都出现：单一类型的占位符总会生成对应类型的字符，但 "****" 可能只包含数字
+// 需要保证时传入 WithEachType，此时所有占位符能够生成的每种类型（数字、小写字母、大写字母、特殊字符）
+// 都至少出现一次，占位符的个数不足以容纳所有类型时返回 errLengthTooShort
+// 同时支持 WithoutAmbiguous
+func RandByTemplate(tpl string, opts ...option.Option[CodeConfig]) (string, error) {
+	return defaultGenerator.RandByTemplate(tpl, opts...)
+}
+
+// RandByPattern 根据类正则表达式的模式生成随机字符串
+// 支持以下语法，不支持的语法会返回 errInvalidPattern：
+//   - 字符组：[A-Z]、[a-z0-9_]，字符组内同样支持 \d
+//   - 转义：\d 数字、\w 字母数字或下划线，其余 \x 表示字符 x 本身
+//   - 量词：{n} 重复 n 次，{m,n} 随机重复 m 到 n 次
+//   - . 表示任意数字或字母，其余字符原样输出
+//
+// 例如 "[A-Z]{3}-\d{4}" 可能生成 "KQD-4821"
+// 默认不保证每种类型都出现，例如 "[A-Z0-9]{4}" 可能只包含数字
+// 需要保证时传入 WithEachType，此时所有字符组能够生成的每种类型都至少出现一次，注意 \w 中的 _ 属于特殊字符；
+// 只有量词的最小次数参与保证，例如 "[A-Z0-9]{1,4}" 总是返回 errLengthTooShort，与实际的重复次数无关
+// 同时支持 WithoutAmbiguous
+func RandByPattern(pattern string, opts ...option.Option[CodeConfig]) (string, error) {
+	return defaultGenerator.RandByPattern(pattern, opts...)
+}
+
+// RandPronounceable 生成辅音与元音交替的小写字符串，便于朗读和记忆
+// 例如 "bamoteki"，请保证输入的 length >= 0，否则会返回 errLengthLessThanZero
+// 可读性是以熵为代价的，不要用于密码、令牌等安全敏感的场景
+func RandPronounceable(length int) (string, error) {
+	return defaultGenerator.RandPronounceable(length)
+}
+
+// RandByTemplate 根据模板生成随机字符串，规则与包级别的 RandByTemplate 相同
+func (g *Generator) RandByTemplate(tpl string, opts ...option.Option[CodeConfig]) (string, error) {
+	var cfg CodeConfig
+	option.Apply(&cfg, opts...)
+
+	segments := make([]segment, 0, len(tpl))
+	for i := 0; i < len(tpl); i++ {
+		c := tpl[i]
+		if c == '\\' {
+			if i == len(tpl)-1 {
+				return "", errInvalidTemplate
+			}
+			i++
+			_, size := utf8.DecodeRuneInString(tpl[i:])
+			segments = append(segments, segment{literal: tpl[i : i+size]})
+			i += size - 1
+			continue
+		}
+		if charset, ok := templatePlaceholders[c]; ok {
+			segments = append(segments, segment{charset: cfg.apply(charset)})
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(tpl[i:])
+		segments = append(segments, segment{literal: tpl[i : i+size]})
+		i += size - 1
+	}
+	if cfg.requireEach {
+		if err := g.requireEach(segments); err != nil {
+			return "", err
+		}
+	}
+	return g.render(segments), nil
+}
+
+// RandByPattern 根据类正则表达式的模式生成随机字符串，规则与包级别的 RandByPattern 相同
+func (g *Generator) RandByPattern(pattern string, opts ...option.Option[CodeConfig]) (string, error) {
+	var cfg CodeConfig
+	option.Apply(&cfg, opts...)
+
+	segments := make([]segment, 0, len(pattern))
+	for i := 0; i < len(pattern); {
+		var (
+			seg segment
+			err error
+		)
+		switch c := pattern[i]; c {
+		case '[':
+			end := classEnd(pattern[i+1:])
+			if end < 0 {
+				return "", errInvalidPattern
+			}
+			seg.charset, err = parseCharClass(pattern[i+1 : i+1+end])
+			if err != nil {
+				return "", err
+			}
+			i += end + 2
+		case '\\':
+			if i == len(pattern)-1 {
+				return "", errInvalidPattern
+			}
+			if charset, ok := patternEscapes[pattern[i+1]]; ok {
+				seg.charset = charset
+				i += 2
+				break
+			}
+			_, size := utf8.DecodeRuneInString(pattern[i+1:])
+			seg.literal = pattern[i+1 : i+1+size]
+			i += 1 + size
+		case '.':
+			seg.charset = CharsetDigit + CharsetLowerCase + CharsetUpperCase
+			i++
+		case '(', ')', '|', '*', '+', '?', '^', '$', '{', '}', ']':
+			return "", errInvalidPattern
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[i:])
+			seg.literal = pattern[i : i+size]
+			i += size
+		}
+		if seg.literal == "" {
+			seg.charset = cfg.apply(seg.charset)
+			if seg.charset == "" {
+				return "", errInvalidPattern
+			}
+		}
+
+		// 处理紧跟在后面的量词
+		minRepeat, repeat := 1, 1
+		if i < len(pattern) && pattern[i] == '{' {
+			end := strings.IndexByte(pattern[i:], '}')
+			if end < 0 {
+				return "", errInvalidPattern
+			}
+			var maxRepeat int
+			minRepeat, maxRepeat, err = parseQuantifier(pattern[i+1 : i+end])
+			if err != nil {
+				return "", err
+			}
+			repeat = minRepeat + g.Int(maxRepeat-minRepeat+1)
+			i += end + 1
+		}
+		for j := 0; j < repeat; j++ {
+			seg.optional = j >= minRepeat
+			segments = append(segments, seg)
+		}
+	}
+	if cfg.requireEach {
+		if err := g.requireEach(segments); err != nil {
+			return "", err
+		}
+	}
+	return g.render(segments), nil
+}
+
+// RandPronounceable 生成辅音与元音交替的小写字符串，规则与包级别的 RandPronounceable 相同
+func (g *Generator) RandPronounceable(length int) (string, error) {
+	if length < 0 {
+		return "", errLengthLessThanZero
+	}
+	// 随机决定以辅音还是元音开头
+	offset := g.Int(2)
+	segments := make([]segment, length)
+	for i := range segments {
+		if (i+offset)%2 == 0 {
+			segments[i].charset = pronounceableConsonants
+		} else {
+			segments[i].charset = pronounceableVowels
+		}
+	}
+	return g.render(segments), nil
+}
+
+// requireEach 实现 WithEachType，保证 segments 能够生成的每种类型在结果中都至少出现一次
+// 为每种类型分配一个不同的位置（二分图匹配），再把该位置的字符集限制为这种类型的字符
+// 无法为所有类型分配位置时返回 errLengthTooShort
+func (g *Generator) requireEach(segments []segment) error {
+	var (
+		all       Type
+		types     = make([]Type, len(segments))
+		positions = make([]int, 0, len(segments))
+	)
+	for i, seg := range segments {
+		if seg.literal != "" {
+			continue
+		}
+		types[i] = charsetType(seg.charset)
+		all |= types[i]
+		if !seg.optional {
+			positions = append(positions, i)
+		}
+	}
+	// 打乱候选位置，使被限制的位置是随机的
+	g.Shuffle(len(positions), func(i, j int) {
+		positions[i], positions[j] = positions[j], positions[i]
+	})
+
+	// owner 记录每个位置分配到的类型
+	owner := make(map[int]Type, len(typeCharsetPairs))
+	var assign func(typ Type, visited map[int]bool) bool
+	assign = func(typ Type, visited map[int]bool) bool {
+		for _, i := range positions {
+			if visited[i] || types[i]&typ == 0 {
+				continue
+			}
+			visited[i] = true
+			// 位置空闲，或者原来的类型可以换到其他位置
+			if other, ok := owner[i]; !ok || assign(other, visited) {
+				owner[i] = typ
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range typeCharsetPairs {
+		if all&p.Key != 0 && !assign(p.Key, map[int]bool{}) {
+			return errLengthTooShort
+		}
+	}
+	for i, typ := range owner {
+		segments[i].charset = strings.Map(func(r rune) rune {
+			if typeOf(r) == typ {
+				return r
+			}
+			return -1
+		}, segments[i].charset)
+	}
+	return nil
+}
+
+// charsetType 返回 charset 中的字符包含的所有类型
+func charsetType(charset string) Type {
+	var res Type
+	for _, c := range charset {
+		res |= typeOf(c)
+	}
+	return res
+}
+
+// render 依次输出每个位置的字符
+func (g *Generator) render(segments []segment) string {
+	var sb strings.Builder
+	sb.Grow(len(segments))
+	for _, seg := range segments {
+		if seg.literal != "" {
+			sb.WriteString(seg.literal)
+			continue
+		}
+		sb.WriteByte(seg.charset[g.Int(len(seg.charset))])
+	}
+	return sb.String()
+}
+
+// classEnd 返回字符组中第一个未转义的 ] 的下标，例如 `\]a]` 返回 3，找不到时返回 -1
+func classEnd(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			// 跳过被转义的字符
+			i++
+		case ']':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseCharClass 解析字符组 [] 中的内容，返回去重后的字符集
+// 只支持 ASCII 字符
+func parseCharClass(class string) (string, error) {
+	if class == "" || class[0] == '^' {
+		return "", errInvalidPattern
+	}
+	var (
+		seen [128]bool
+		sb   strings.Builder
+	)
+	add := func(c byte) error {
+		if c >= utf8.RuneSelf {
+			return errInvalidPattern
+		}
+		if !seen[c] {
+			seen[c] = true
+			sb.WriteByte(c)
+		}
+		return nil
+	}
+	for i := 0; i < len(class); i++ {
+		c := class[i]
+		if c == '\\' {
+			if i == len(class)-1 {
+				return "", errInvalidPattern
+			}
+			i++
+			if charset, ok := patternEscapes[class[i]]; ok {
+				for j := 0; j < len(charset); j++ {
+					_ = add(charset[j])
+				}
+				continue
+			}
+			c = class[i]
+		}
+		// 范围，例如 a-z
+		if i+2 < len(class) && class[i+1] == '-' {
+			to := class[i+2]
+			if to < c {
+				return "", errInvalidPattern
+			}
+			for ch := c; ch <= to; ch++ {
+				if err := add(ch); err != nil {
+					return "", err
+				}
+				if ch == to {
+					break
+				}
+			}
+			i += 2
+			continue
+		}
+		if err := add(c); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+// parseQuantifier 解析量词 {} 中的内容，支持 n 和 m,n 两种形式
+func parseQuantifier(quantifier string) (int, int, error) {
+	minStr, maxStr, found := strings.Cut(quantifier, ",")
+	minRepeat, err := strconv.Atoi(minStr)
+	if err != nil {
+		return 0, 0, errInvalidPattern
+	}
+	maxRepeat := minRepeat
+	if found {
+		if maxRepeat, err = strconv.Atoi(maxStr); err != nil {
+			return 0, 0, errInvalidPattern
+		}
+	}
+	if minRepeat < 0 || maxRepeat < minRepeat || maxRepeat > maxPatternRepeat {
+		return 0, 0, errInvalidPattern
+	}
+	return minRepeat, maxRepeat, nil
+}
@@ -0,0 +1,276 @@
+/**
+ * Description：
+ * FileName：pattern_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 21:36:44
+ * Remark：
+ */
+
+package randx
+
+import (
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRandByTemplate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tpl       string
+		opts      []option.Option[CodeConfig]
+		wantMatch string
+		wantErr   error
+	}{
+		{
+			name:      "empty",
+			tpl:       "",
+			wantMatch: `^$`,
+		},
+		{
+			name:      "placeholders",
+			tpl:       "AAA-999-aaa",
+			wantMatch: `^[A-Z]{3}-[0-9]{3}-[a-z]{3}$`,
+		},
+		{
+			name:      "alnum and special",
+			tpl:       "**!",
+			wantMatch: `^[0-9a-zA-Z]{2}[^0-9a-zA-Z]$`,
+		},
+		{
+			name:      "escape",
+			tpl:       `\A\9-A`,
+			wantMatch: `^A9-[A-Z]$`,
+		},
+		{
+			name:      "unicode literal",
+			tpl:       "编号-99",
+			wantMatch: `^编号-[0-9]{2}$`,
+		},
+		{
+			name:      "without ambiguous",
+			tpl:       "AAAAAAAAAA9999999999",
+			opts:      []option.Option[CodeConfig]{WithoutAmbiguous()},
+			wantMatch: `^[A-HJ-NP-Z]{10}[2-9]{10}$`,
+		},
+		{
+			name:    "dangling escape",
+			tpl:     `AA\`,
+			wantErr: errInvalidTemplate,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				res, err := RandByTemplate(tc.tpl, tc.opts...)
+				assert.Equal(t, tc.wantErr, err)
+				if err != nil {
+					return
+				}
+				assert.Regexp(t, tc.wantMatch, res)
+			}
+		})
+	}
+}
+
+func TestRandByPattern(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pattern   string
+		opts      []option.Option[CodeConfig]
+		wantMatch string
+		wantErr   error
+	}{
+		{
+			name:      "class and quantifier",
+			pattern:   `[A-Z]{3}-\d{4}`,
+			wantMatch: `^[A-Z]{3}-[0-9]{4}$`,
+		},
+		{
+			name:      "multiple ranges",
+			pattern:   `[a-f0-9_]{8}`,
+			wantMatch: `^[a-f0-9_]{8}$`,
+		},
+		{
+			name:      "range quantifier",
+			pattern:   `x\w{2,5}`,
+			wantMatch: `^x\w{2,5}$`,
+		},
+		{
+			name:      "dot and escape",
+			pattern:   `.\.\d[\d]`,
+			wantMatch: `^[0-9a-zA-Z]\.[0-9][0-9]$`,
+		},
+		{
+			name:      "without ambiguous",
+			pattern:   `[0-9lIO]{20}`,
+			opts:      []option.Option[CodeConfig]{WithoutAmbiguous()},
+			wantMatch: `^[2-9]{20}$`,
+		},
+		{
+			name:    "only ambiguous",
+			pattern: `[01]`,
+			opts:    []option.Option[CodeConfig]{WithoutAmbiguous()},
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:      "escaped bracket in class",
+			pattern:   `[\]a]{3}`,
+			wantMatch: `^[\]a]{3}$`,
+		},
+		{
+			name:      "escaped backslash in class",
+			pattern:   `[\\x]{3}-`,
+			wantMatch: `^[\\x]{3}-$`,
+		},
+		{
+			name:    "unclosed escaped class",
+			pattern: `[a\]`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "unclosed class",
+			pattern: `[A-Z`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "negated class",
+			pattern: `[^A-Z]`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "reversed range",
+			pattern: `[z-a]`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "unsupported syntax",
+			pattern: `a+`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "invalid quantifier",
+			pattern: `a{3,1}`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "quantifier too large",
+			pattern: `a{100000}`,
+			wantErr: errInvalidPattern,
+		},
+		{
+			name:    "unclosed quantifier",
+			pattern: `a{3`,
+			wantErr: errInvalidPattern,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				res, err := RandByPattern(tc.pattern, tc.opts...)
+				assert.Equal(t, tc.wantErr, err)
+				if err != nil {
+					return
+				}
+				assert.Regexp(t, tc.wantMatch, res)
+			}
+		})
+	}
+}
+
+func TestRandEachType(t *testing.T) {
+	testCases := []struct {
+		name    string
+		gen     func(opts ...option.Option[CodeConfig]) (string, error)
+		want    Type
+		wantErr error
+	}{
+		{
+			name: "template alnum",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByTemplate("***", opts...)
+			},
+			want: TypeDigit | TypeLowerCase | TypeUpperCase,
+		},
+		{
+			name: "template shares types",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByTemplate("A9*", opts...)
+			},
+			want: TypeDigit | TypeLowerCase | TypeUpperCase,
+		},
+		{
+			name: "template too short",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByTemplate("-**", opts...)
+			},
+			wantErr: errLengthTooShort,
+		},
+		{
+			name: "pattern multi class",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByPattern(`[A-Z0-9]{2}`, opts...)
+			},
+			want: TypeDigit | TypeUpperCase,
+		},
+		{
+			name: "pattern across classes",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByPattern(`[a-z0-9][0-9]`, opts...)
+			},
+			want: TypeDigit | TypeLowerCase,
+		},
+		{
+			name: "pattern without ambiguous",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByPattern(`[0-9lIOX]{2}`, append(opts, WithoutAmbiguous())...)
+			},
+			want: TypeDigit | TypeUpperCase,
+		},
+		{
+			name: "pattern optional repeats",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByPattern(`[A-Z0-9]{1,4}`, opts...)
+			},
+			wantErr: errLengthTooShort,
+		},
+		{
+			name: "pattern too short",
+			gen: func(opts ...option.Option[CodeConfig]) (string, error) {
+				return RandByPattern(`\w{3}`, opts...)
+			},
+			wantErr: errLengthTooShort,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				res, err := tc.gen(WithEachType())
+				assert.Equal(t, tc.wantErr, err)
+				if err != nil {
+					return
+				}
+				assert.Equal(t, tc.want, charsetType(res))
+			}
+		})
+	}
+}
+
+func TestRandByPatternSeed(t *testing.T) {
+	a, err := NewGenerator(WithSeed(5)).RandByPattern(`[A-Z]{3}-\d{2,6}`)
+	assert.NoError(t, err)
+	b, err := NewGenerator(WithSeed(5)).RandByPattern(`[A-Z]{3}-\d{2,6}`)
+	assert.NoError(t, err)
+	assert.Equal(t, a, b)
+}
+
+func TestRandPronounceable(t *testing.T) {
+	_, err := RandPronounceable(-1)
+	assert.Equal(t, errLengthLessThanZero, err)
+
+	for i := 0; i < 50; i++ {
+		res, err := RandPronounceable(8)
+		assert.NoError(t, err)
+		assert.Regexp(t, `^([bcdfghjklmnprstvwxz][aeiou]){4}$|^([aeiou][bcdfghjklmnprstvwxz]){4}$`, res)
+	}
+}
@@ -0,0 +1,82 @@
+/**
+ * Description：
+ * FileName：policy.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 21:48:03
+ * Remark：
+ */
+
+package randx
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	errPasswordTooShort    = errors.New("echo:长度小于最小长度")
+	errPasswordTooLong     = errors.New("echo:长度大于最大长度")
+	errPasswordMissingType = errors.New("echo:缺少必须包含的字符类型")
+	errPasswordInvalidChar = errors.New("echo:包含不允许的字符")
+)
+
+// Policy 密码策略，使用与 RandCode 相同的 Type 描述字符类型
+type Policy struct {
+	// MinLength 最小长度（按字节计算），0 表示不限制
+	MinLength int
+	// MaxLength 最大长度（按字节计算），0 表示不限制
+	MaxLength int
+	// Required 必须包含的字符类型，每种类型至少出现一次
+	Required Type
+	// Allowed 允许出现的字符类型，为 0 时与 Required 相同
+	// Allowed 与 Required 都为 0 时不限制字符类型，只校验长度
+	Allowed Type
+}
+
+// Validate 校验 s 是否满足密码策略
+// 不满足时返回对应的错误，满足时返回 nil
+func (p Policy) Validate(s string) error {
+	if p.MinLength > 0 && len(s) < p.MinLength {
+		return errPasswordTooShort
+	}
+	if p.MaxLength > 0 && len(s) > p.MaxLength {
+		return errPasswordTooLong
+	}
+
+	allowed := p.Allowed
+	if allowed == 0 {
+		allowed = p.Required
+	}
+	if allowed == 0 {
+		return nil
+	}
+	// 统计 s 中实际出现的字符类型
+	var present Type
+	for _, c := range s {
+		typ := typeOf(c)
+		if typ == 0 || allowed&typ == 0 {
+			return errPasswordInvalidChar
+		}
+		present |= typ
+	}
+	if present&p.Required != p.Required {
+		return errPasswordMissingType
+	}
+	return nil
+}
+
+// ValidatePassword 使用最小长度和类型快速校验，等价于 Policy{MinLength: minLength, Required: typ}.Validate(s)
+// 即 s 的长度不小于 minLength，只包含 typ 中的字符类型，并且 typ 中的每种类型都至少出现一次
+func ValidatePassword(s string, typ Type, minLength int) error {
+	return Policy{MinLength: minLength, Required: typ}.Validate(s)
+}
+
+// typeOf 返回字符所属的类型，不属于任何类型时返回 0
+func typeOf(c rune) Type {
+	for _, p := range typeCharsetPairs {
+		if strings.ContainsRune(p.Value, c) {
+			return p.Key
+		}
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+/**
+ * Description：
+ * FileName：policy_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 22:02:37
+ * Remark：
+ */
+
+package randx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  Policy
+		input   string
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			policy: Policy{MinLength: 8, Required: TypeDigit | TypeLowerCase | TypeUpperCase},
+			input:  "Abcdef12",
+		},
+		{
+			name:   "length only min",
+			policy: Policy{MinLength: 8},
+			input:  "abcdefgh",
+		},
+		{
+			name:   "length only max",
+			policy: Policy{MaxLength: 8},
+			input:  "abc",
+		},
+		{
+			name:   "length only any character",
+			policy: Policy{MinLength: 4, MaxLength: 16},
+			input:  "密码 Ab1!",
+		},
+		{
+			name:    "length only too short",
+			policy:  Policy{MinLength: 8},
+			input:   "abc",
+			wantErr: errPasswordTooShort,
+		},
+		{
+			name:    "length only too long",
+			policy:  Policy{MaxLength: 2},
+			input:   "abc",
+			wantErr: errPasswordTooLong,
+		},
+		{
+			name:    "too short",
+			policy:  Policy{MinLength: 8, Required: TypeDigit},
+			input:   "1234",
+			wantErr: errPasswordTooShort,
+		},
+		{
+			name:    "too long",
+			policy:  Policy{MaxLength: 4, Required: TypeDigit},
+			input:   "12345",
+			wantErr: errPasswordTooLong,
+		},
+		{
+			name:    "missing type",
+			policy:  Policy{Required: TypeDigit | TypeUpperCase},
+			input:   "12345",
+			wantErr: errPasswordMissingType,
+		},
+		{
+			name:    "not allowed type",
+			policy:  Policy{Required: TypeDigit},
+			input:   "123a",
+			wantErr: errPasswordInvalidChar,
+		},
+		{
+			name:   "allowed but not required",
+			policy: Policy{Required: TypeDigit, Allowed: TypeDigit | TypeSpecial},
+			input:  "12-3",
+		},
+		{
+			name:    "unknown char",
+			policy:  Policy{Required: TypeMixed},
+			input:   "Aa1!密",
+			wantErr: errPasswordInvalidChar,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantErr, tc.policy.Validate(tc.input))
+		})
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.NoError(t, ValidatePassword("abc123", TypeDigit|TypeLowerCase, 6))
+	assert.Equal(t, errPasswordTooShort, ValidatePassword("abc12", TypeDigit|TypeLowerCase, 6))
+	assert.Equal(t, errPasswordMissingType, ValidatePassword("abcdef", TypeDigit|TypeLowerCase, 6))
+}
@@ -10,13 +10,15 @@ package randx
 
 import (
 	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
 	"github.com/carefuly/careful-echo/tuple/pair"
-	"math/rand"
+	"strings"
 )
 
 var (
 	errTypeNotSupported   = errors.New("echo:不支持的类型")
 	errLengthLessThanZero = errors.New("echo:长度必须大于等于0")
+	errLengthTooShort     = errors.New("echo:长度小于需要包含的字符类型数量")
 )
 
 type Type int
@@ -41,6 +43,8 @@ const (
 	CharsetUpperCase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	// CharsetSpecial 特殊字符数组
 	CharsetSpecial = " ~!@#$%^&*()_+-=[]{};'\\:\"|,./<>?"
+	// CharsetAmbiguous 容易混淆的字符，参考 WithoutAmbiguous
+	CharsetAmbiguous = "0O1lI"
 )
 
 var (
@@ -53,44 +57,61 @@ var (
 	}
 )
 
-// RandCode 根据传入的长度和类型生成随机字符串
-// 请保证输入的 length >= 0，否则会返回 errLengthLessThanZero
-// 请保证输入的 typ 的取值范围在 (0, type.MIXED] 内，否则会返回 errTypeNotSupported
-func RandCode(length int, typ Type) (string, error) {
-	if length < 0 {
-		return "", errLengthLessThanZero
+// CodeConfig 生成随机字符串时的可选配置，通过 WithEachType、WithoutAmbiguous 设置
+type CodeConfig struct {
+	// requireEach 每种类型至少包含一个字符
+	requireEach bool
+	// excludeAmbiguous 排除容易混淆的字符
+	excludeAmbiguous bool
+}
+
+// WithEachType 保证生成的字符串中 typ 包含的每种类型都至少出现一次
+// 长度小于类型数量时会返回 errLengthTooShort
+// 用于 RandByTemplate、RandByPattern 时保证模板或模式能够生成的每种类型都至少出现一次
+func WithEachType() option.Option[CodeConfig] {
+	return func(c *CodeConfig) {
+		c.requireEach = true
 	}
-	if length == 0 {
-		return "", nil
+}
+
+// WithoutAmbiguous 排除 CharsetAmbiguous 中容易混淆的字符，例如 0/O、1/l/I
+// 适用于需要用户肉眼识别并手动输入的验证码
+func WithoutAmbiguous() option.Option[CodeConfig] {
+	return func(c *CodeConfig) {
+		c.excludeAmbiguous = true
 	}
-	if typ > TypeMixed {
-		return "", errTypeNotSupported
+}
+
+// apply 按照配置过滤字符集
+func (c CodeConfig) apply(charset string) string {
+	if !c.excludeAmbiguous {
+		return charset
 	}
-	charset := ""
-	for _, p := range typeCharsetPairs {
-		if (typ & p.Key) == p.Key {
-			charset += p.Value
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(CharsetAmbiguous, r) {
+			return -1
 		}
-	}
-	return RandStrByCharset(length, charset)
+		return r
+	}, charset)
+}
+
+// RandCode 根据传入的长度和类型生成随机字符串
+// 请保证输入的 length >= 0，否则会返回 errLengthLessThanZero
+// 请保证输入的 typ 的取值范围在 (0, type.MIXED] 内，否则会返回 errTypeNotSupported
+// 可以通过 WithEachType、WithoutAmbiguous 调整生成规则
+// 使用 crypto/rand 作为随机源，可以直接用于验证码、令牌等安全敏感的场景
+// 对性能敏感且不涉及安全的场景可以使用 NewGenerator(WithMathRand()) 创建的 Generator
+func RandCode(length int, typ Type, opts ...option.Option[CodeConfig]) (string, error) {
+	return defaultGenerator.RandCode(length, typ, opts...)
 }
 
 // RandStrByCharset 根据传入的长度和字符集生成随机字符串
 // 请保证输入的 length >= 0，否则会返回 errLengthLessThanZero
 // 请保证输入的字符集不为空字符串，否则会返回 errTypeNotSupported
 // 字符集内部字符可以无序或重复
+// 使用 crypto/rand 作为随机源，可以直接用于验证码、令牌等安全敏感的场景
 func RandStrByCharset(length int, charset string) (string, error) {
-	if length < 0 {
-		return "", errLengthLessThanZero
-	}
-	if length == 0 {
-		return "", nil
-	}
-	charsetSize := len(charset)
-	if charsetSize == 0 {
-		return "", errTypeNotSupported
-	}
-	return generate(charset, length, getFirstMask(charsetSize)), nil
+	return defaultGenerator.RandStrByCharset(length, charset)
 }
 
 func getFirstMask(charsetSize int) int {
@@ -102,7 +123,7 @@ func getFirstMask(charsetSize int) int {
 }
 
 // generate 根据传入的随机源和长度生成随机字符串,一次随机，多次使用
-func generate(source string, length, idxBits int) string {
+func generate(src source, charset string, length, idxBits int) string {
 
 	// 掩码
 	// 例如： 使用低6位：0000 0000 --> 0011 1111
@@ -112,19 +133,19 @@ func generate(source string, length, idxBits int) string {
 	remain := 63 / idxBits
 
 	// cache 随机位缓存
-	cache := rand.Int63()
+	cache := int64(src.Uint64() >> 1)
 
 	result := make([]byte, length)
 
 	for i := 0; i < length; {
 		// 如果使用次数剩余0，重新获取随机
 		if remain == 0 {
-			cache, remain = rand.Int63(), 63/idxBits
+			cache, remain = int64(src.Uint64()>>1), 63/idxBits
 		}
 
 		// 利用掩码获取有效的随机数位
-		if randIndex := int(cache & int64(idxMask)); randIndex < len(source) {
-			result[i] = source[randIndex]
+		if randIndex := int(cache & int64(idxMask)); randIndex < len(charset) {
+			result[i] = charset[randIndex]
 			i++
 		}
 
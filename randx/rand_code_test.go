@@ -10,6 +10,7 @@ package randx_test
 
 import (
 	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
 	"github.com/carefuly/careful-echo/randx"
 	"github.com/stretchr/testify/assert"
 	"regexp"
@@ -141,6 +142,19 @@ func TestRandCode(t *testing.T) {
 	}
 }
 
+func TestRandCodeOptions(t *testing.T) {
+	// 包外可以声明 option 切片并在多次调用之间复用
+	opts := []option.Option[randx.CodeConfig]{randx.WithEachType(), randx.WithoutAmbiguous()}
+	for i := 0; i < 50; i++ {
+		code, err := randx.RandCode(3, randx.TypeDigit|randx.TypeLowerCase|randx.TypeUpperCase, opts...)
+		assert.NoError(t, err)
+		assert.Regexp(t, "^[2-9a-km-zA-HJ-NP-Z]{3}$", code)
+		assert.Regexp(t, "[0-9]", code)
+		assert.Regexp(t, "[a-z]", code)
+		assert.Regexp(t, "[A-Z]", code)
+	}
+}
+
 func TestRandStrByCharset(t *testing.T) {
 	matchFunc := func(str, charset string) bool {
 		for _, c := range str {
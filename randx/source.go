@@ -0,0 +1,81 @@
+/**
+ * Description：
+ * FileName：source.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 16:40:25
+ * Remark：
+ */
+
+package randx
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+)
+
+// source 随机位来源，与 math/rand/v2 的 rand.Source 相同
+// Uint64 返回一个在 [0, 1<<64) 范围内均匀分布的整数
+type source = rand.Source
+
+var (
+	_ source = cryptoSource{}
+	_ source = mathSource{}
+	_ source = &lockedSource{}
+)
+
+// cryptoSource 基于 crypto/rand 的随机源
+// 生成的随机数不可预测，适用于验证码、令牌等安全敏感的场景，并发安全
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	readCrypto(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// readCrypto 使用 crypto/rand 填充 b
+func readCrypto(b []byte) {
+	if _, err := crand.Read(b); err != nil {
+		// 操作系统的随机源不可用时无法安全地继续，与 Go 1.24 之后的 crypto/rand 行为保持一致
+		panic("echo: 读取 crypto/rand 失败: " + err.Error())
+	}
+}
+
+// mathSource 基于 math/rand/v2 全局随机源的随机源
+// 全局随机源按线程维护状态，并发调用不会竞争同一把锁
+// 速度快但不适用于安全敏感的场景，并发安全
+type mathSource struct{}
+
+func (mathSource) Uint64() uint64 {
+	return rand.Uint64()
+}
+
+// lockedSource 使用互斥锁保护一个非并发安全的随机源
+type lockedSource struct {
+	lock sync.Mutex
+	src  rand.Source
+}
+
+func (l *lockedSource) Uint64() uint64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.src.Uint64()
+}
+
+// newSeededSource 使用 seed 创建一个确定性的 ChaCha8 随机源
+// 相同的 seed 总是产生相同的随机序列
+func newSeededSource(seed uint64) rand.Source {
+	var b [32]byte
+	binary.LittleEndian.PutUint64(b[:], seed)
+	return rand.NewChaCha8(b)
+}
+
+// newCryptoSeededSource 创建一个 ChaCha8 随机源，32 字节的种子全部来自 crypto/rand
+// 与 newSeededSource 不同，种子有完整的 256 位熵，无法通过枚举种子预测输出
+func newCryptoSeededSource() rand.Source {
+	var b [32]byte
+	readCrypto(b[:])
+	return rand.NewChaCha8(b)
+}
@@ -0,0 +1,227 @@
+/**
+ * Description：
+ * FileName：weighted.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 20:10:34
+ * Remark：
+ */
+
+package randx
+
+import (
+	"errors"
+	echo "github.com/carefuly/careful-echo"
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"math"
+	"sync"
+)
+
+var (
+	errEmptyCandidates = errors.New("echo:候选项不能为空")
+	errInvalidWeight   = errors.New("echo:权重必须大于等于0且总和大于0")
+)
+
+// 本文件中需要随机数的函数都接收一个 *Generator
+// 传入 nil 时使用基于 crypto/rand 的默认生成器，传入 NewGenerator(WithSeed(seed)) 时结果可以复现
+
+func orDefault(g *Generator) *Generator {
+	if g == nil {
+		return defaultGenerator
+	}
+	return g
+}
+
+// WeightedChoice 按权重随机选择的选择器
+// 使用 Vose 别名方法，构建时间复杂度为 O(n)，每次选择为 O(1)
+// 构建后不可修改，并发安全（前提是传入的 Generator 并发安全）
+type WeightedChoice[T any] struct {
+	items []T
+	// prob[i] 为第 i 列保留自身的概率，否则选择 alias[i]
+	prob  []float64
+	alias []int
+}
+
+// NewWeightedChoice 创建一个按权重随机选择的选择器
+// 每个候选项被选中的概率为 权重 / 权重总和，权重为 0 的候选项永远不会被选中
+// 候选项为空、存在负数权重或权重总和为 0 时返回错误
+func NewWeightedChoice[T any, W echo.RealNumber](candidates []pair.Pair[T, W]) (*WeightedChoice[T], error) {
+	n := len(candidates)
+	if n == 0 {
+		return nil, errEmptyCandidates
+	}
+	total := 0.0
+	for _, c := range candidates {
+		w := float64(c.Value)
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, errInvalidWeight
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, errInvalidWeight
+	}
+
+	res := &WeightedChoice[T]{
+		items: make([]T, n),
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+	}
+	// 将权重缩放到平均值为 1，小于 1 的列需要从大于 1 的列借概率
+	scaled := make([]float64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, c := range candidates {
+		res.items[i] = c.Key
+		scaled[i] = float64(c.Value) * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		res.prob[s] = scaled[s]
+		res.alias[s] = l
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// 剩余的列由于浮点误差可能不精确等于 1，直接视为 1
+	for _, i := range large {
+		res.prob[i] = 1
+	}
+	for _, i := range small {
+		res.prob[i] = 1
+	}
+	return res, nil
+}
+
+// Pick 按权重随机选择一个候选项
+func (w *WeightedChoice[T]) Pick(g *Generator) T {
+	g = orDefault(g)
+	i := g.Int(len(w.items))
+	if g.Float() < w.prob[i] {
+		return w.items[i]
+	}
+	return w.items[w.alias[i]]
+}
+
+// SmoothWeighted 平滑加权轮询，算法与 Nginx 的 upstream 相同
+// 在一个周期（权重总和次）内每个候选项被选中的次数严格等于其权重，并且会尽量均匀地穿插选择
+// 例如权重 {a:5, b:1, c:1} 的选择序列为 a a b a c a a
+// 不依赖随机数，并发安全
+type SmoothWeighted[T any] struct {
+	lock    sync.Mutex
+	items   []T
+	weights []int
+	current []int
+	total   int
+}
+
+// NewSmoothWeighted 创建一个平滑加权轮询选择器
+// 候选项为空、存在负数权重或权重总和为 0 时返回错误
+func NewSmoothWeighted[T any](candidates []pair.Pair[T, int]) (*SmoothWeighted[T], error) {
+	if len(candidates) == 0 {
+		return nil, errEmptyCandidates
+	}
+	res := &SmoothWeighted[T]{
+		items:   make([]T, len(candidates)),
+		weights: make([]int, len(candidates)),
+		current: make([]int, len(candidates)),
+	}
+	for i, c := range candidates {
+		if c.Value < 0 {
+			return nil, errInvalidWeight
+		}
+		res.items[i], res.weights[i] = c.Split()
+		res.total += c.Value
+	}
+	if res.total == 0 {
+		return nil, errInvalidWeight
+	}
+	return res, nil
+}
+
+// Next 返回下一个被选中的候选项
+func (s *SmoothWeighted[T]) Next() T {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// 每个候选项的当前权重加上自身权重，选出当前权重最大的，再将它的当前权重减去总权重
+	selected := 0
+	for i := range s.current {
+		s.current[i] += s.weights[i]
+		if s.current[i] > s.current[selected] {
+			selected = i
+		}
+	}
+	s.current[selected] -= s.total
+	return s.items[selected]
+}
+
+// Reservoir 蓄水池抽样，从长度未知的数据流中等概率地抽取 k 个元素
+// 只需要 O(k) 的空间，不是并发安全的
+type Reservoir[T any] struct {
+	g       *Generator
+	k       int
+	seen    int
+	samples []T
+}
+
+// NewReservoir 创建一个容量为 k 的蓄水池
+// k <= 0 时蓄水池永远为空
+func NewReservoir[T any](k int, g *Generator) *Reservoir[T] {
+	if k < 0 {
+		k = 0
+	}
+	return &Reservoir[T]{
+		g:       orDefault(g),
+		k:       k,
+		samples: make([]T, 0, k),
+	}
+}
+
+// Add 向蓄水池中添加一个元素
+// 第 n 个元素以 k/n 的概率进入蓄水池，并随机替换掉一个已有元素
+func (r *Reservoir[T]) Add(val T) {
+	r.seen++
+	if len(r.samples) < r.k {
+		r.samples = append(r.samples, val)
+		return
+	}
+	if r.k == 0 {
+		return
+	}
+	if j := r.g.Int(r.seen); j < r.k {
+		r.samples[j] = val
+	}
+}
+
+// Seen 返回已经添加过的元素个数
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// Samples 返回当前的抽样结果
+// 添加的元素少于 k 个时返回全部元素，返回的是一个新的切片
+func (r *Reservoir[T]) Samples() []T {
+	res := make([]T, len(r.samples))
+	copy(res, r.samples)
+	return res
+}
+
+// SampleWithoutReplacement 从切片中不放回地随机抽取 k 个元素
+// 每个下标最多被抽中一次，返回顺序也是随机的，不会修改原切片
+// k 大于切片长度时返回全部元素的一个随机排列，k <= 0 时返回空切片
+func SampleWithoutReplacement[T any](src []T, k int, g *Generator) []T {
+	indexes := orDefault(g).Sample(len(src), k)
+	res := make([]T, len(indexes))
+	for i, idx := range indexes {
+		res[i] = src[idx]
+	}
+	return res
+}
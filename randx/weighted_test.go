@@ -0,0 +1,220 @@
+/**
+ * Description：
+ * FileName：weighted_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 20:44:58
+ * Remark：
+ */
+
+package randx
+
+import (
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestNewWeightedChoice(t *testing.T) {
+	testCases := []struct {
+		name       string
+		candidates []pair.Pair[string, float64]
+		wantErr    error
+	}{
+		{
+			name:    "nil",
+			wantErr: errEmptyCandidates,
+		},
+		{
+			name: "negative weight",
+			candidates: []pair.Pair[string, float64]{
+				pair.NewPair("a", 1.0),
+				pair.NewPair("b", -1.0),
+			},
+			wantErr: errInvalidWeight,
+		},
+		{
+			name: "NaN weight",
+			candidates: []pair.Pair[string, float64]{
+				pair.NewPair("a", math.NaN()),
+			},
+			wantErr: errInvalidWeight,
+		},
+		{
+			name: "total zero",
+			candidates: []pair.Pair[string, float64]{
+				pair.NewPair("a", 0.0),
+				pair.NewPair("b", 0.0),
+			},
+			wantErr: errInvalidWeight,
+		},
+		{
+			name: "valid",
+			candidates: []pair.Pair[string, float64]{
+				pair.NewPair("a", 0.5),
+				pair.NewPair("b", 0.0),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewWeightedChoice(tc.candidates)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestWeightedChoicePick(t *testing.T) {
+	w, err := NewWeightedChoice([]pair.Pair[string, int]{
+		pair.NewPair("a", 1),
+		pair.NewPair("b", 0),
+		pair.NewPair("c", 3),
+		pair.NewPair("d", 6),
+	})
+	require.NoError(t, err)
+
+	g := NewGenerator(WithSeed(42))
+	counts := make(map[string]int, 4)
+	const n = 100000
+	for i := 0; i < n; i++ {
+		counts[w.Pick(g)]++
+	}
+	assert.Equal(t, 0, counts["b"])
+	assert.InDelta(t, 0.1, float64(counts["a"])/n, 0.01)
+	assert.InDelta(t, 0.3, float64(counts["c"])/n, 0.01)
+	assert.InDelta(t, 0.6, float64(counts["d"])/n, 0.01)
+
+	// 相同种子结果相同
+	g1, g2 := NewGenerator(WithSeed(7)), NewGenerator(WithSeed(7))
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, w.Pick(g1), w.Pick(g2))
+	}
+
+	// nil 使用默认生成器
+	assert.Contains(t, []string{"a", "c", "d"}, w.Pick(nil))
+}
+
+func TestSmoothWeighted(t *testing.T) {
+	testCases := []struct {
+		name       string
+		candidates []pair.Pair[string, int]
+		want       []string
+		wantErr    error
+	}{
+		{
+			name:    "nil",
+			wantErr: errEmptyCandidates,
+		},
+		{
+			name: "negative weight",
+			candidates: []pair.Pair[string, int]{
+				pair.NewPair("a", -1),
+			},
+			wantErr: errInvalidWeight,
+		},
+		{
+			name: "total zero",
+			candidates: []pair.Pair[string, int]{
+				pair.NewPair("a", 0),
+			},
+			wantErr: errInvalidWeight,
+		},
+		{
+			name: "nginx example",
+			candidates: []pair.Pair[string, int]{
+				pair.NewPair("a", 5),
+				pair.NewPair("b", 1),
+				pair.NewPair("c", 1),
+			},
+			want: []string{"a", "a", "b", "a", "c", "a", "a", "a", "a", "b", "a", "c", "a", "a"},
+		},
+		{
+			name: "zero weight never selected",
+			candidates: []pair.Pair[string, int]{
+				pair.NewPair("a", 1),
+				pair.NewPair("b", 0),
+				pair.NewPair("c", 1),
+			},
+			want: []string{"a", "c", "a", "c"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewSmoothWeighted(tc.candidates)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			res := make([]string, 0, len(tc.want))
+			for range tc.want {
+				res = append(res, s.Next())
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	r := NewReservoir[int](3, NewGenerator(WithSeed(1)))
+	r.Add(1)
+	r.Add(2)
+	assert.Equal(t, []int{1, 2}, r.Samples())
+
+	for i := 3; i <= 100; i++ {
+		r.Add(i)
+	}
+	assert.Equal(t, 100, r.Seen())
+	samples := r.Samples()
+	assert.Len(t, samples, 3)
+	seen := make(map[int]struct{}, 3)
+	for _, v := range samples {
+		assert.True(t, v >= 1 && v <= 100)
+		seen[v] = struct{}{}
+	}
+	assert.Len(t, seen, 3)
+
+	empty := NewReservoir[int](0, nil)
+	empty.Add(1)
+	assert.Equal(t, []int{}, empty.Samples())
+}
+
+func TestReservoirUniform(t *testing.T) {
+	// 每个元素进入蓄水池的概率都应该是 k/n
+	g := NewGenerator(WithSeed(42))
+	counts := make([]int, 10)
+	const rounds = 20000
+	for i := 0; i < rounds; i++ {
+		r := NewReservoir[int](2, g)
+		for j := 0; j < 10; j++ {
+			r.Add(j)
+		}
+		for _, v := range r.Samples() {
+			counts[v]++
+		}
+	}
+	for _, cnt := range counts {
+		assert.InDelta(t, 0.2, float64(cnt)/rounds, 0.015)
+	}
+}
+
+func TestSampleWithoutReplacement(t *testing.T) {
+	src := []string{"a", "b", "c", "d", "e"}
+	g := NewGenerator(WithSeed(3))
+
+	res := SampleWithoutReplacement(src, 3, g)
+	assert.Len(t, res, 3)
+	assert.Subset(t, src, res)
+
+	all := SampleWithoutReplacement(src, 10, g)
+	sort.Strings(all)
+	assert.Equal(t, src, all)
+
+	assert.Equal(t, []string{}, SampleWithoutReplacement(src, 0, nil))
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, src)
+
+	assert.Equal(t,
+		SampleWithoutReplacement(src, 3, NewGenerator(WithSeed(9))),
+		SampleWithoutReplacement(src, 3, NewGenerator(WithSeed(9))))
+}
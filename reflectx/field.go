@@ -0,0 +1,134 @@
+/**
+ * Description：
+ * FileName：field.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 00:31:05
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/internal/reflectutil"
+	"reflect"
+	"strings"
+)
+
+// SetField 设置 ptr 指向的结构体中路径为 path 的字段，嵌套的字段使用 . 连接，例如 "DB.Pool.MaxConns"
+// 路径中为 nil 的指针会被自动分配，嵌入结构体的字段可以直接使用字段名访问
+// value 会被转换为字段的类型（参考 Convert），无法转换时返回 errs.NewErrInvalidType
+// 字段不存在或者未导出时返回 errs.NewErrFieldNotFound
+func SetField(ptr any, path string, value any) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errs.NewErrInvalidType("结构体指针", typeString(ptr))
+	}
+	cur := v.Elem()
+	for _, name := range strings.Split(path, ".") {
+		cur = indirect(cur)
+		if !cur.IsValid() {
+			return errs.NewErrFieldNotFound(path)
+		}
+		if cur.Kind() != reflect.Struct {
+			return errs.NewErrInvalidType("struct", cur.Type().String())
+		}
+		sf, ok := cur.Type().FieldByName(name)
+		if !ok || !sf.IsExported() {
+			return errs.NewErrFieldNotFound(path)
+		}
+		for i, idx := range sf.Index {
+			if i > 0 {
+				// 经过嵌入的结构体指针
+				if cur = indirect(cur); !cur.IsValid() {
+					return errs.NewErrFieldNotFound(path)
+				}
+			}
+			cur = cur.Field(idx)
+		}
+	}
+	if !cur.CanSet() {
+		return errs.NewErrFieldNotFound(path)
+	}
+	res, err := Convert(value, cur.Type())
+	if err != nil {
+		return err
+	}
+	cur.Set(res)
+	return nil
+}
+
+// Convert 将 value 转换为 typ 类型
+//   - nil 转换为零值
+//   - 可以直接赋值时直接赋值
+//   - typ 为指针时转换为指向的类型，再分配新的指针
+//   - 数字之间可以互相转换，整数溢出、截断或者负数转换为无符号数时返回错误，
+//     浮点数允许精度损失，只有超出表示范围时返回错误
+//   - 相同种类的基础类型之间可以互相转换，例如 type Status string 与 string
+//   - string 与 []byte 可以互相转换
+//
+// 无法转换时返回 errs.NewErrInvalidType
+func Convert(value any, typ reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(typ), nil
+	}
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(typ) {
+		res := reflect.New(typ).Elem()
+		res.Set(src)
+		return res, nil
+	}
+	if typ.Kind() == reflect.Pointer {
+		elem, err := Convert(value, typ.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		res := reflect.New(typ.Elem())
+		res.Elem().Set(elem)
+		return res, nil
+	}
+	sk, dk := src.Kind(), typ.Kind()
+	switch {
+	case reflectutil.IsNumber(sk) && reflectutil.IsNumber(dk):
+		res, ok := reflectutil.ConvertNumber(src, typ)
+		if !ok {
+			return reflect.Value{}, errs.NewErrInvalidType(typ.String(), value)
+		}
+		return res, nil
+	case sk == dk && reflectutil.IsBasic(sk):
+		return src.Convert(typ), nil
+	case isString(src.Type()) && isBytes(typ), isBytes(src.Type()) && isString(typ):
+		return src.Convert(typ), nil
+	default:
+		return reflect.Value{}, errs.NewErrInvalidType(typ.String(), value)
+	}
+}
+
+// indirect 解引用指针，为 nil 的指针会被分配，无法分配时返回无效的 reflect.Value
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			if !v.CanSet() {
+				return reflect.Value{}
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func typeString(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(v).String()
+}
+
+func isString(typ reflect.Type) bool {
+	return typ.Kind() == reflect.String
+}
+
+func isBytes(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8
+}
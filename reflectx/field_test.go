@@ -0,0 +1,220 @@
+/**
+ * Description：
+ * FileName：field_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 01:52:46
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type status string
+
+type Base struct {
+	ID int64
+}
+
+type base struct {
+	CreatedBy string
+}
+
+type pool struct {
+	MaxConns int    `echo:"max_conns"`
+	Timeout  string `echo:"-"`
+}
+
+type db struct {
+	DSN  string `echo:"dsn"`
+	Pool *pool  `echo:"pool"`
+}
+
+type user struct {
+	*Base
+	base
+	Name    string `echo:"name"`
+	Age     uint8  `echo:"age"`
+	Status  status
+	Score   *float64
+	Ratio   float32
+	Data    []byte
+	DB      db `echo:"db"`
+	Created time.Time
+	secret  string
+}
+
+func TestSetField(t *testing.T) {
+	testCases := []struct {
+		name    string
+		path    string
+		value   any
+		want    func(u *user) any
+		wantVal any
+		wantErr error
+	}{
+		{
+			name:    "string",
+			path:    "Name",
+			value:   "Tom",
+			want:    func(u *user) any { return u.Name },
+			wantVal: "Tom",
+		},
+		{
+			name:    "int to uint8",
+			path:    "Age",
+			value:   18,
+			want:    func(u *user) any { return u.Age },
+			wantVal: uint8(18),
+		},
+		{
+			name:    "overflow",
+			path:    "Age",
+			value:   256,
+			wantErr: errs.NewErrInvalidType("uint8", 256),
+		},
+		{
+			name:    "negative to unsigned",
+			path:    "Age",
+			value:   -1,
+			wantErr: errs.NewErrInvalidType("uint8", -1),
+		},
+		{
+			name:    "named string",
+			path:    "Status",
+			value:   "active",
+			want:    func(u *user) any { return u.Status },
+			wantVal: status("active"),
+		},
+		{
+			name:    "value to pointer",
+			path:    "Score",
+			value:   float32(1.5),
+			want:    func(u *user) any { return *u.Score },
+			wantVal: 1.5,
+		},
+		{
+			name:    "float64 to float32",
+			path:    "Ratio",
+			value:   0.1,
+			want:    func(u *user) any { return u.Ratio },
+			wantVal: float32(0.1),
+		},
+		{
+			name:    "string to bytes",
+			path:    "Data",
+			value:   "abc",
+			want:    func(u *user) any { return u.Data },
+			wantVal: []byte("abc"),
+		},
+		{
+			name:    "nil to zero",
+			path:    "Data",
+			value:   nil,
+			want:    func(u *user) any { return u.Data },
+			wantVal: []byte(nil),
+		},
+		{
+			name:    "nested with nil pointer",
+			path:    "DB.Pool.MaxConns",
+			value:   int64(10),
+			want:    func(u *user) any { return u.DB.Pool.MaxConns },
+			wantVal: 10,
+		},
+		{
+			name:    "embedded pointer",
+			path:    "ID",
+			value:   1,
+			want:    func(u *user) any { return u.Base.ID },
+			wantVal: int64(1),
+		},
+		{
+			name:    "embedded unexported struct",
+			path:    "CreatedBy",
+			value:   "admin",
+			want:    func(u *user) any { return u.CreatedBy },
+			wantVal: "admin",
+		},
+		{
+			name:    "int to string",
+			path:    "Name",
+			value:   65,
+			wantErr: errs.NewErrInvalidType("string", 65),
+		},
+		{
+			name:    "unexported",
+			path:    "secret",
+			value:   "x",
+			wantErr: errs.NewErrFieldNotFound("secret"),
+		},
+		{
+			name:    "not found",
+			path:    "DB.Unknown",
+			value:   "x",
+			wantErr: errs.NewErrFieldNotFound("DB.Unknown"),
+		},
+		{
+			name:    "not struct",
+			path:    "Name.Length",
+			value:   1,
+			wantErr: errs.NewErrInvalidType("struct", "string"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u := &user{Data: []byte("x")}
+			err := SetField(u, tc.path, tc.value)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantVal, tc.want(u))
+		})
+	}
+}
+
+func TestSetFieldInvalid(t *testing.T) {
+	var u user
+	assert.Equal(t, errs.NewErrInvalidType("结构体指针", "reflectx.user"), SetField(u, "Name", "Tom"))
+	assert.Equal(t, errs.NewErrInvalidType("结构体指针", "*reflectx.user"), SetField((*user)(nil), "Name", "Tom"))
+	assert.Equal(t, errs.NewErrInvalidType("结构体指针", "nil"), SetField(nil, "Name", "Tom"))
+	assert.Equal(t, errs.NewErrInvalidType("结构体指针", "*int"), SetField(new(int), "Name", "Tom"))
+}
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   any
+		typ     reflect.Type
+		want    any
+		wantErr error
+	}{
+		{name: "assignable", value: 1, typ: reflect.TypeOf(0), want: 1},
+		{name: "interface", value: 1, typ: reflect.TypeOf((*any)(nil)).Elem(), want: 1},
+		{name: "float to int", value: 2.0, typ: reflect.TypeOf(0), want: 2},
+		{name: "truncate", value: 2.5, typ: reflect.TypeOf(0), wantErr: errs.NewErrInvalidType("int", 2.5)},
+		{name: "float64 to float32", value: 0.1, typ: reflect.TypeOf(float32(0)), want: float32(0.1)},
+		{name: "float32 overflow", value: 1e39, typ: reflect.TypeOf(float32(0)), wantErr: errs.NewErrInvalidType("float32", 1e39)},
+		{name: "bytes to string", value: []byte("a"), typ: reflect.TypeOf(""), want: "a"},
+		{name: "bool to string", value: true, typ: reflect.TypeOf(""), wantErr: errs.NewErrInvalidType("string", true)},
+		{name: "pointer", value: 1, typ: reflect.TypeOf((*int64)(nil)), want: func() *int64 { v := int64(1); return &v }()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Convert(tc.value, tc.typ)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tc.typ, res.Type())
+			assert.Equal(t, tc.want, res.Interface())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/**
+ * Description：
+ * FileName：method.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 01:24:52
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"reflect"
+	"strconv"
+)
+
+// CallMethod 调用 obj 的导出方法 name，返回所有的返回值
+// args 会被转换为参数的类型（参考 Convert），支持可变参数
+// 指针接收者的方法需要传入指针，方法不存在时返回 errs.NewErrMethodNotFound，
+// 参数数量不匹配时返回 errs.NewErrInvalidArgCount，参数类型不匹配时返回 errs.NewErrInvalidType
+// 方法本身返回的 error 作为普通的返回值返回
+func CallMethod(obj any, name string, args ...any) ([]any, error) {
+	v := reflect.ValueOf(obj)
+	if !v.IsValid() {
+		return nil, errs.NewErrMethodNotFound("nil", name)
+	}
+	m := v.MethodByName(name)
+	if !m.IsValid() {
+		return nil, errs.NewErrMethodNotFound(v.Type().String(), name)
+	}
+	mt := m.Type()
+	in, variadic := mt.NumIn(), mt.IsVariadic()
+	if variadic && len(args) < in-1 {
+		return nil, errs.NewErrInvalidArgCount(name, ">= "+strconv.Itoa(in-1), len(args))
+	}
+	if !variadic && len(args) != in {
+		return nil, errs.NewErrInvalidArgCount(name, strconv.Itoa(in), len(args))
+	}
+	values := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var typ reflect.Type
+		if variadic && i >= in-1 {
+			typ = mt.In(in - 1).Elem()
+		} else {
+			typ = mt.In(i)
+		}
+		val, err := Convert(arg, typ)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = val
+	}
+	out := m.Call(values)
+	res := make([]any, len(out))
+	for i, o := range out {
+		res[i] = o.Interface()
+	}
+	return res, nil
+}
@@ -0,0 +1,157 @@
+/**
+ * Description：
+ * FileName：method_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 02:38:07
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type greeter struct {
+	prefix string
+}
+
+func (g greeter) Greet(name string) string {
+	return g.prefix + name
+}
+
+func (g greeter) Join(sep string, names ...string) string {
+	return g.prefix + strings.Join(names, sep)
+}
+
+func (g greeter) Div(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errors.New("除数不能为 0")
+	}
+	return a / b, nil
+}
+
+func (g *greeter) SetPrefix(prefix string) {
+	g.prefix = prefix
+}
+
+func TestCallMethod(t *testing.T) {
+	g := &greeter{prefix: "hi "}
+	testCases := []struct {
+		name    string
+		obj     any
+		method  string
+		args    []any
+		want    []any
+		wantErr error
+	}{
+		{
+			name:   "value receiver",
+			obj:    greeter{prefix: "hi "},
+			method: "Greet",
+			args:   []any{"Tom"},
+			want:   []any{"hi Tom"},
+		},
+		{
+			name:   "pointer",
+			obj:    g,
+			method: "Greet",
+			args:   []any{"Tom"},
+			want:   []any{"hi Tom"},
+		},
+		{
+			name:   "convert args",
+			obj:    g,
+			method: "Div",
+			args:   []any{int8(6), 3.0},
+			want:   []any{2, nil},
+		},
+		{
+			name:   "returned error",
+			obj:    g,
+			method: "Div",
+			args:   []any{1, 0},
+			want:   []any{0, errors.New("除数不能为 0")},
+		},
+		{
+			name:   "variadic",
+			obj:    g,
+			method: "Join",
+			args:   []any{",", "a", "b"},
+			want:   []any{"hi a,b"},
+		},
+		{
+			name:   "variadic without extra args",
+			obj:    g,
+			method: "Join",
+			args:   []any{","},
+			want:   []any{"hi "},
+		},
+		{
+			name:    "pointer receiver on value",
+			obj:     greeter{},
+			method:  "SetPrefix",
+			args:    []any{"x"},
+			wantErr: errs.NewErrMethodNotFound("reflectx.greeter", "SetPrefix"),
+		},
+		{
+			name:    "unexported",
+			obj:     g,
+			method:  "prefix",
+			wantErr: errs.NewErrMethodNotFound("*reflectx.greeter", "prefix"),
+		},
+		{
+			name:    "nil",
+			obj:     nil,
+			method:  "Greet",
+			wantErr: errs.NewErrMethodNotFound("nil", "Greet"),
+		},
+		{
+			name:    "arg count",
+			obj:     g,
+			method:  "Greet",
+			args:    []any{"a", "b"},
+			wantErr: errs.NewErrInvalidArgCount("Greet", "1", 2),
+		},
+		{
+			name:    "variadic arg count",
+			obj:     g,
+			method:  "Join",
+			wantErr: errs.NewErrInvalidArgCount("Join", ">= 1", 0),
+		},
+		{
+			name:    "arg type",
+			obj:     g,
+			method:  "Greet",
+			args:    []any{1},
+			wantErr: errs.NewErrInvalidType("string", 1),
+		},
+		{
+			name:    "variadic arg type",
+			obj:     g,
+			method:  "Join",
+			args:    []any{",", "a", 1},
+			wantErr: errs.NewErrInvalidType("string", 1),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := CallMethod(tc.obj, tc.method, tc.args...)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestCallMethodPointerReceiver(t *testing.T) {
+	g := &greeter{}
+	res, err := CallMethod(g, "SetPrefix", "hello ")
+	assert.NoError(t, err)
+	assert.Equal(t, []any{}, res)
+	assert.Equal(t, "hello ", g.prefix)
+	assert.Equal(t, "hello Tom", g.Greet("Tom"))
+}
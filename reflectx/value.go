@@ -0,0 +1,35 @@
+/**
+ * Description：
+ * FileName：value.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 00:12:37
+ * Remark：
+ */
+
+package reflectx
+
+import "reflect"
+
+// IsNil 判断 v 是否为 nil
+// 与 v == nil 不同，包装了 nil 指针、nil 切片、nil map 等的接口也被视为 nil，例如 IsNil((*T)(nil)) 为 true
+func IsNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// IsZero 判断 v 是否为对应类型的零值，nil 以及包装了 nil 的接口都是零值
+// 指向零值的指针不是零值
+func IsZero(v any) bool {
+	if v == nil {
+		return true
+	}
+	return reflect.ValueOf(v).IsZero()
+}
@@ -0,0 +1,72 @@
+/**
+ * Description：
+ * FileName：value_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 01:40:12
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsNil(t *testing.T) {
+	var nilErr *myErr
+	var err error = nilErr
+	testCases := []struct {
+		name string
+		v    any
+		want bool
+	}{
+		{name: "nil", v: nil, want: true},
+		{name: "nil pointer", v: (*int)(nil), want: true},
+		{name: "interface wrapping nil pointer", v: err, want: true},
+		{name: "nil slice", v: []int(nil), want: true},
+		{name: "nil map", v: map[string]int(nil), want: true},
+		{name: "nil func", v: (func())(nil), want: true},
+		{name: "nil chan", v: (chan int)(nil), want: true},
+		{name: "empty slice", v: []int{}, want: false},
+		{name: "zero int", v: 0, want: false},
+		{name: "error", v: errors.New("x"), want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsNil(tc.v))
+		})
+	}
+	// 接口本身不为 nil
+	assert.False(t, err == nil)
+}
+
+func TestIsZero(t *testing.T) {
+	testCases := []struct {
+		name string
+		v    any
+		want bool
+	}{
+		{name: "nil", v: nil, want: true},
+		{name: "nil pointer", v: (*int)(nil), want: true},
+		{name: "zero int", v: 0, want: true},
+		{name: "empty string", v: "", want: true},
+		{name: "zero struct", v: user{}, want: true},
+		{name: "nil slice", v: []int(nil), want: true},
+		{name: "empty slice", v: []int{}, want: false},
+		{name: "pointer to zero", v: new(int), want: false},
+		{name: "non zero", v: user{Name: "Tom"}, want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsZero(tc.v))
+		})
+	}
+}
+
+type myErr struct{}
+
+func (e *myErr) Error() string {
+	return "my error"
+}
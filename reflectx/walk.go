@@ -0,0 +1,144 @@
+/**
+ * Description：
+ * FileName：walk.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 00:58:19
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/internal/reflectutil"
+	"reflect"
+)
+
+// SkipStruct 在 WalkFunc 中返回 SkipStruct 时不会继续遍历该字段内部的字段，Walk 不会返回该错误
+var SkipStruct = errors.New("echo: 跳过结构体")
+
+// Field 遍历到的字段
+type Field struct {
+	reflect.StructField
+	// Path 从根结构体开始的字段路径，例如 "DB.MaxConns"，可以直接用于 SetField
+	// 嵌入结构体的字段被提升，路径中不包含嵌入结构体的名称
+	Path string
+	// Tag 使用 WithTag 时为标签的值
+	Tag string
+	// Value 字段的值，obj 为指针时可以设置
+	Value reflect.Value
+}
+
+// WalkFunc 遍历字段时调用的函数，返回 SkipStruct 以外的错误时停止遍历
+type WalkFunc func(f Field) error
+
+// WalkConfig Walk 的可选配置，通过 WithTag 设置
+type WalkConfig struct {
+	tag string
+}
+
+// WithTag 只遍历带有标签 tag 的字段，标签为空或者为 "-" 的字段以及它内部的字段都会被忽略
+// 没有标签的嵌入结构体不会被忽略，它的字段被提升
+func WithTag(tag string) option.Option[WalkConfig] {
+	return func(c *WalkConfig) {
+		c.tag = tag
+	}
+}
+
+// Walk 按照深度优先的顺序遍历 obj 中的导出字段，先访问字段本身，再访问它内部的字段
+// 结构体以及指向结构体的非 nil 指针会被继续遍历，同一个指针只会被遍历一次
+// 嵌入结构体本身不会被访问，它的字段被提升到外层
+// obj 必须是结构体或者结构体指针，否则返回 errs.NewErrInvalidType，nil 指针不会访问任何字段
+func Walk(obj any, fn WalkFunc, opts ...option.Option[WalkConfig]) error {
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return errs.NewErrInvalidType("struct", typeString(obj))
+	}
+	w := &walker{fn: fn, visited: map[uintptr]struct{}{}}
+	option.Apply(&w.cfg, opts...)
+	return w.walk(v, "")
+}
+
+type walker struct {
+	cfg     WalkConfig
+	fn      WalkFunc
+	visited map[uintptr]struct{}
+}
+
+func (w *walker) walk(v reflect.Value, path string) error {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		fv := v.Field(i)
+		if w.promoted(sf) {
+			if inner, ok := w.enter(fv); ok {
+				if err := w.walk(inner, path); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		tag, ok := w.match(sf)
+		if !ok || !sf.IsExported() {
+			continue
+		}
+		f := Field{StructField: sf, Path: reflectutil.JoinPath(path, sf.Name), Tag: tag, Value: fv}
+		err := w.fn(f)
+		if errors.Is(err, SkipStruct) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if inner, ok := w.enter(fv); ok {
+			if err := w.walk(inner, f.Path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// promoted 字段是否为需要提升的嵌入结构体，使用 WithTag 时带有标签的嵌入结构体被视为普通的字段
+func (w *walker) promoted(sf reflect.StructField) bool {
+	if !sf.Anonymous {
+		return false
+	}
+	typ := sf.Type
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct && (w.cfg.tag == "" || sf.Tag.Get(w.cfg.tag) == "")
+}
+
+// match 字段是否满足标签的过滤条件
+func (w *walker) match(sf reflect.StructField) (string, bool) {
+	if w.cfg.tag == "" {
+		return "", true
+	}
+	tag := sf.Tag.Get(w.cfg.tag)
+	return tag, tag != "" && tag != "-"
+}
+
+// enter 返回需要继续遍历的结构体，nil 指针以及已经遍历过的指针不会被遍历
+func (w *walker) enter(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		if _, ok := w.visited[v.Pointer()]; ok {
+			return reflect.Value{}, false
+		}
+		w.visited[v.Pointer()] = struct{}{}
+		v = v.Elem()
+	}
+	return v, v.Kind() == reflect.Struct
+}
@@ -0,0 +1,119 @@
+/**
+ * Description：
+ * FileName：walk_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 02:15:33
+ * Remark：
+ */
+
+package reflectx
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type node struct {
+	Val  int
+	Next *node
+}
+
+func TestWalk(t *testing.T) {
+	u := &user{Base: &Base{ID: 1}, DB: db{Pool: &pool{}}}
+	testCases := []struct {
+		name string
+		obj  any
+		opts []option.Option[WalkConfig]
+		skip string
+		want []string
+	}{
+		{
+			name: "all fields",
+			obj:  u,
+			want: []string{"ID", "CreatedBy", "Name", "Age", "Status", "Score", "Ratio", "Data", "DB", "DB.DSN", "DB.Pool", "DB.Pool.MaxConns", "DB.Pool.Timeout", "Created"},
+		},
+		{
+			name: "nil embedded pointer and nil field",
+			obj:  user{},
+			want: []string{"CreatedBy", "Name", "Age", "Status", "Score", "Ratio", "Data", "DB", "DB.DSN", "DB.Pool", "Created"},
+		},
+		{
+			name: "tag",
+			obj:  u,
+			opts: []option.Option[WalkConfig]{WithTag("echo")},
+			want: []string{"Name:name", "Age:age", "DB:db", "DB.DSN:dsn", "DB.Pool:pool", "DB.Pool.MaxConns:max_conns"},
+		},
+		{
+			name: "skip struct",
+			obj:  u,
+			opts: []option.Option[WalkConfig]{WithTag("echo")},
+			skip: "DB.Pool",
+			want: []string{"Name:name", "Age:age", "DB:db", "DB.DSN:dsn", "DB.Pool:pool"},
+		},
+		{
+			name: "nil pointer",
+			obj:  (*user)(nil),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			err := Walk(tc.obj, func(f Field) error {
+				if f.Tag != "" {
+					got = append(got, f.Path+":"+f.Tag)
+				} else {
+					got = append(got, f.Path)
+				}
+				if f.Path == tc.skip {
+					return SkipStruct
+				}
+				return nil
+			}, tc.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWalkSet(t *testing.T) {
+	u := &user{}
+	err := Walk(u, func(f Field) error {
+		if f.Tag == "name" {
+			f.Value.SetString("Tom")
+		}
+		return nil
+	}, WithTag("echo"))
+	require.NoError(t, err)
+	assert.Equal(t, "Tom", u.Name)
+}
+
+func TestWalkErr(t *testing.T) {
+	assert.Equal(t, errs.NewErrInvalidType("struct", "int"), Walk(1, func(f Field) error { return nil }))
+
+	stop := errors.New("stop")
+	var got []string
+	err := Walk(user{}, func(f Field) error {
+		got = append(got, f.Path)
+		if f.Path == "Name" {
+			return stop
+		}
+		return nil
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []string{"CreatedBy", "Name"}, got)
+}
+
+func TestWalkCycle(t *testing.T) {
+	n := &node{Val: 1}
+	n.Next = &node{Val: 2, Next: n}
+	var got []string
+	require.NoError(t, Walk(n, func(f Field) error {
+		got = append(got, f.Path)
+		return nil
+	}))
+	assert.Equal(t, []string{"Val", "Next", "Next.Val", "Next.Next", "Next.Next.Val", "Next.Next.Next"}, got)
+}
@@ -8,7 +8,10 @@
 
 package slice
 
-import "github.com/carefuly/careful-echo/internal/slice"
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/internal/slice"
+)
 
 // Add 在切片的指定索引位置插入元素
 // 参数：
@@ -21,3 +24,39 @@ import "github.com/carefuly/careful-echo/internal/slice"
 func Add[T any](src []T, element T, index int) ([]T, error) {
 	return slice.Add(src, element, index)
 }
+
+// InsertAll 在切片的指定索引位置依次插入多个元素
+// 容量足够时原地移动元素，不会分配内存；容量不足时只会分配一次
+// 注意 ts 不应与 src 共享底层数组，否则原地移动时 ts 的内容可能被覆盖
+// 参数：
+// src: 原始切片
+// index: 插入位置索引，取值范围为 [0, len(src)]
+// ts: 要插入的元素
+// 返回值：
+// 插入元素后的切片（容量足够时与原切片共享底层数组）
+// 错误信息（索引越界时返回错误）
+func InsertAll[T any](src []T, index int, ts ...T) ([]T, error) {
+	length := len(src)
+	if index < 0 || index > length {
+		return nil, errs.NewErrIndexOutOfRange(length, index)
+	}
+	if len(ts) == 0 {
+		return src, nil
+	}
+
+	newLen := length + len(ts)
+	if newLen > cap(src) {
+		// 容量不足时直接拷贝到新切片，无需先移动再拷贝
+		res := make([]T, newLen)
+		copy(res, src[:index])
+		copy(res[index:], ts)
+		copy(res[index+len(ts):], src[index:])
+		return res, nil
+	}
+
+	src = src[:newLen]
+	// 将 [index:length] 区间的元素整体后移 len(ts) 位
+	copy(src[index+len(ts):], src[index:length])
+	copy(src[index:], ts)
+	return src, nil
+}
@@ -60,3 +60,83 @@ func ExampleAdd() {
 	// [1 2 233 3 4]
 	// echo: 下标超出范围，长度 4, 下标 -1
 }
+
+func TestInsertAll(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		index     int
+		ts        []int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "index 0",
+			slice:     []int{1, 2, 3},
+			index:     0,
+			ts:        []int{7, 8},
+			wantSlice: []int{7, 8, 1, 2, 3},
+		},
+		{
+			name:      "index middle",
+			slice:     []int{1, 2, 3},
+			index:     1,
+			ts:        []int{7, 8},
+			wantSlice: []int{1, 7, 8, 2, 3},
+		},
+		{
+			name:      "append on last",
+			slice:     []int{1, 2, 3},
+			index:     3,
+			ts:        []int{7},
+			wantSlice: []int{1, 2, 3, 7},
+		},
+		{
+			name:      "no element",
+			slice:     []int{1, 2, 3},
+			index:     1,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "enough capacity",
+			slice:     append(make([]int, 0, 10), 1, 2, 3),
+			index:     1,
+			ts:        []int{7, 8, 9},
+			wantSlice: []int{1, 7, 8, 9, 2, 3},
+		},
+		{
+			name:    "index out of range",
+			slice:   []int{1, 2, 3},
+			index:   4,
+			ts:      []int{7},
+			wantErr: errs.NewErrIndexOutOfRange(3, 4),
+		},
+		{
+			name:    "index less than 0",
+			slice:   []int{1, 2, 3},
+			index:   -1,
+			ts:      []int{7},
+			wantErr: errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := InsertAll(tc.slice, tc.index, tc.ts...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestInsertAllAllocs(t *testing.T) {
+	src := make([]int, 3, 64)
+	ts := []int{7, 8, 9}
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = InsertAll(src[:3], 1, ts...)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
@@ -8,7 +8,12 @@
 
 package slice
 
-import echo "github.com/carefuly/careful-echo"
+import (
+	echo "github.com/carefuly/careful-echo"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"math"
+	"slices"
+)
 
 // Max 返回切片中的最大值
 // 参数：
@@ -58,3 +63,184 @@ func Sum[T echo.Number](values []T) T {
 	}
 	return res
 }
+
+// SumChecked 计算切片所有元素的总和，形如 Sum，但会检查溢出
+// Sum 在溢出时会静默回绕（例如 int8 的 127 + 1 = -128），SumChecked 会返回错误
+// 参数：
+// 包含整数的切片
+// 返回值：
+// 所有元素的总和
+// 错误信息（溢出时返回错误，包含发生溢出的元素下标）
+func SumChecked[T echo.Integer](values []T) (T, error) {
+	var res T
+	for i, v := range values {
+		sum := res + v
+		// 加正数结果变小，或者加负数结果变大，说明发生了回绕
+		if (v > 0 && sum < res) || (v < 0 && sum > res) {
+			var zero T
+			return zero, errs.NewErrOverflow(i, v)
+		}
+		res = sum
+	}
+	return res, nil
+}
+
+// KahanSum 使用 Kahan 补偿求和算法计算浮点数切片的总和
+// 相比 Sum，可以显著降低大量浮点数累加时的舍入误差
+// 参数：
+// 包含浮点数的切片
+// 返回值：
+// 所有元素的总和
+func KahanSum[T echo.Float](values []T) T {
+	var sum, c T
+	for _, v := range values {
+		// c 记录上一次累加丢失的低位部分
+		y := v - c
+		t := sum + y
+		c = (t - sum) - y
+		sum = t
+	}
+	return sum
+}
+
+// TryMax 返回切片中的最大值
+// 形如 Max，但在切片为空时不会 panic，而是返回零值和 false
+// 参数：
+// 包含数值的切片
+// 返回值：
+// 切片中的最大值
+// 切片是否非空
+func TryMax[T echo.RealNumber](values []T) (T, bool) {
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	return Max[T](values), true
+}
+
+// TryMin 返回切片中的最小值
+// 形如 Min，但在切片为空时不会 panic，而是返回零值和 false
+// 参数：
+// 包含数值的切片
+// 返回值：
+// 切片中的最小值
+// 切片是否非空
+func TryMin[T echo.RealNumber](values []T) (T, bool) {
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	return Min[T](values), true
+}
+
+// MaxBy 使用比较函数返回切片中的最大元素
+// 存在多个最大元素时返回第一个
+// 参数：
+// 任意类型的切片
+// 比较函数
+// 返回值：
+// 切片中的最大元素
+// 切片是否非空
+func MaxBy[T any](src []T, compare compareFunc[T]) (T, bool) {
+	if len(src) == 0 {
+		var zero T
+		return zero, false
+	}
+	res := src[0]
+	for _, v := range src[1:] {
+		if compare(v, res) > 0 {
+			res = v
+		}
+	}
+	return res, true
+}
+
+// MinBy 使用比较函数返回切片中的最小元素
+// 存在多个最小元素时返回第一个
+// 参数：
+// 任意类型的切片
+// 比较函数
+// 返回值：
+// 切片中的最小元素
+// 切片是否非空
+func MinBy[T any](src []T, compare compareFunc[T]) (T, bool) {
+	if len(src) == 0 {
+		var zero T
+		return zero, false
+	}
+	res := src[0]
+	for _, v := range src[1:] {
+		if compare(v, res) < 0 {
+			res = v
+		}
+	}
+	return res, true
+}
+
+// MinMax 使用比较函数一次遍历同时返回切片中的最小元素和最大元素
+// 存在多个最小（最大）元素时返回第一个
+// 参数：
+// 任意类型的切片
+// 比较函数
+// 返回值：
+// 切片中的最小元素
+// 切片中的最大元素
+// 切片是否非空
+func MinMax[T any](src []T, compare compareFunc[T]) (T, T, bool) {
+	if len(src) == 0 {
+		var zero T
+		return zero, zero, false
+	}
+	minVal, maxVal := src[0], src[0]
+	for _, v := range src[1:] {
+		if compare(v, minVal) < 0 {
+			minVal = v
+		}
+		if compare(v, maxVal) > 0 {
+			maxVal = v
+		}
+	}
+	return minVal, maxVal, true
+}
+
+// Median 返回切片的中位数
+// 元素个数为偶数时返回中间两个数的平均值
+// 不会修改原切片
+// 参数：
+// 包含数值的切片
+// 返回值：
+// 中位数
+// 切片是否非空
+func Median[T echo.RealNumber](values []T) (float64, bool) {
+	return Percentile[T](values, 50)
+}
+
+// Percentile 返回切片的第 p 百分位数
+// 采用线性插值法，p 的取值范围为 [0, 100]
+// 不会修改原切片
+// 参数：
+// 包含数值的切片
+// 百分位，0 表示最小值，100 表示最大值
+// 返回值：
+// 百分位数
+// 切片非空且 p 合法时返回 true
+func Percentile[T echo.RealNumber](values []T, p float64) (float64, bool) {
+	if len(values) == 0 || p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, false
+	}
+
+	sorted := make([]float64, len(values))
+	for i, v := range values {
+		sorted[i] = float64(v)
+	}
+	slices.Sort(sorted)
+
+	// 计算排名并在相邻两个元素之间插值
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower], true
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower)), true
+}
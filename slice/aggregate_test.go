@@ -9,6 +9,7 @@
 package slice
 
 import (
+	"github.com/carefuly/careful-echo/internal/errs"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -98,3 +99,269 @@ func TestSum(t *testing.T) {
 		})
 	}
 }
+
+func TestTryMax(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []Integer
+		want   Integer
+		wantOk bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "values",
+			input:  []Integer{2, 3, 1},
+			want:   3,
+			wantOk: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := TryMax[Integer](tc.input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestTryMin(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []Integer
+		want   Integer
+		wantOk bool
+	}{
+		{
+			name:  "empty",
+			input: []Integer{},
+		},
+		{
+			name:   "values",
+			input:  []Integer{3, 1, 2},
+			want:   1,
+			wantOk: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := TryMin[Integer](tc.input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+type rankUser struct {
+	name  string
+	score int
+}
+
+func compareScore(a, b rankUser) int {
+	return a.score - b.score
+}
+
+func TestMaxByMinBy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []rankUser
+		wantMax rankUser
+		wantMin rankUser
+		wantOk  bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:    "value",
+			input:   []rankUser{{name: "a", score: 1}},
+			wantMax: rankUser{name: "a", score: 1},
+			wantMin: rankUser{name: "a", score: 1},
+			wantOk:  true,
+		},
+		{
+			name: "first wins on tie",
+			input: []rankUser{
+				{name: "a", score: 2},
+				{name: "b", score: 3},
+				{name: "c", score: 1},
+				{name: "d", score: 3},
+				{name: "e", score: 1},
+			},
+			wantMax: rankUser{name: "b", score: 3},
+			wantMin: rankUser{name: "c", score: 1},
+			wantOk:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxVal, ok := MaxBy(tc.input, compareScore)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantMax, maxVal)
+
+			minVal, ok := MinBy(tc.input, compareScore)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantMin, minVal)
+
+			minVal, maxVal, ok = MinMax(tc.input, compareScore)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantMin, minVal)
+			assert.Equal(t, tc.wantMax, maxVal)
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []Integer
+		want   float64
+		wantOk bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "odd",
+			input:  []Integer{3, 1, 2},
+			want:   2,
+			wantOk: true,
+		},
+		{
+			name:   "even",
+			input:  []Integer{4, 1, 3, 2},
+			want:   2.5,
+			wantOk: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := append([]Integer(nil), tc.input...)
+			res, ok := Median[Integer](input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, res)
+			// 不会修改原切片
+			assert.Equal(t, tc.input, input)
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []float64
+		p      float64
+		want   float64
+		wantOk bool
+	}{
+		{
+			name: "nil",
+			p:    50,
+		},
+		{
+			name:  "p less than 0",
+			input: []float64{1, 2},
+			p:     -1,
+		},
+		{
+			name:  "p greater than 100",
+			input: []float64{1, 2},
+			p:     101,
+		},
+		{
+			name:   "p 0",
+			input:  []float64{5, 1, 3},
+			p:      0,
+			want:   1,
+			wantOk: true,
+		},
+		{
+			name:   "p 100",
+			input:  []float64{5, 1, 3},
+			p:      100,
+			want:   5,
+			wantOk: true,
+		},
+		{
+			name:   "interpolation",
+			input:  []float64{10, 20, 30, 40, 50},
+			p:      90,
+			want:   46,
+			wantOk: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := Percentile(tc.input, tc.p)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.InDelta(t, tc.want, res, 1e-9)
+		})
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []int8
+		want    int8
+		wantErr error
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:  "values",
+			input: []int8{100, 20, 7},
+			want:  127,
+		},
+		{
+			name:  "negative",
+			input: []int8{-100, -28},
+			want:  -128,
+		},
+		{
+			name:    "positive overflow",
+			input:   []int8{100, 20, 8},
+			wantErr: errs.NewErrOverflow(2, int8(8)),
+		},
+		{
+			name:    "negative overflow",
+			input:   []int8{-100, -29},
+			wantErr: errs.NewErrOverflow(1, int8(-29)),
+		},
+		{
+			name:  "back into range",
+			input: []int8{100, -50, 70},
+			want:  120,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := SumChecked(tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestSumCheckedUnsigned(t *testing.T) {
+	_, err := SumChecked([]uint8{200, 56})
+	assert.Equal(t, errs.NewErrOverflow(1, uint8(56)), err)
+
+	res, err := SumChecked([]uint8{200, 55})
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(255), res)
+}
+
+func TestKahanSum(t *testing.T) {
+	input := make([]float64, 0, 10001)
+	input = append(input, 1)
+	for i := 0; i < 10000; i++ {
+		input = append(input, 1e-16)
+	}
+	// 逐个累加时 1e-16 会被 1 吞掉
+	assert.Equal(t, float64(1), Sum(input))
+	assert.InDelta(t, 1+1e-12, KahanSum(input), 1e-18)
+	assert.Equal(t, float32(0), KahanSum[float32](nil))
+}
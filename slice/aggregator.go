@@ -0,0 +1,133 @@
+/**
+ * Description：
+ * FileName：aggregator.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 14:38:50
+ * Remark：
+ */
+
+package slice
+
+import (
+	echo "github.com/carefuly/careful-echo"
+	"math"
+)
+
+// Aggregator 流式统计聚合器
+// 每次 Add 只更新常数个状态，无需保存所有元素，适合处理数据流或超大数据集
+// 支持个数、总和、平均值、方差、标准差、最小值、最大值
+// 众数和直方图需要保存全部元素，请使用 Mode 和 Histogram
+// 零值可以直接使用，但不是线程安全的
+type Aggregator[T echo.RealNumber] struct {
+	count int
+	// sum 和 c 用于 Kahan 补偿求和
+	sum float64
+	c   float64
+	// mean 和 m2 用于 Welford 算法计算方差
+	mean float64
+	m2   float64
+	min  T
+	max  T
+}
+
+// Add 向聚合器中添加元素
+func (a *Aggregator[T]) Add(values ...T) {
+	for _, v := range values {
+		if a.count == 0 || v < a.min {
+			a.min = v
+		}
+		if a.count == 0 || v > a.max {
+			a.max = v
+		}
+		a.count++
+
+		f := float64(v)
+		y := f - a.c
+		t := a.sum + y
+		a.c = (t - a.sum) - y
+		a.sum = t
+
+		delta := f - a.mean
+		a.mean += delta / float64(a.count)
+		a.m2 += delta * (f - a.mean)
+	}
+}
+
+// Merge 将另一个聚合器的统计结果合并进来
+// 可用于先分块并行统计，再汇总结果
+func (a *Aggregator[T]) Merge(other *Aggregator[T]) {
+	if other.count == 0 {
+		return
+	}
+	if a.count == 0 {
+		*a = *other
+		return
+	}
+	if other.min < a.min {
+		a.min = other.min
+	}
+	if other.max > a.max {
+		a.max = other.max
+	}
+
+	// Chan 等人提出的并行方差合并公式
+	n1, n2 := float64(a.count), float64(other.count)
+	n := n1 + n2
+	delta := other.mean - a.mean
+	a.mean += delta * n2 / n
+	a.m2 += other.m2 + delta*delta*n1*n2/n
+	a.count += other.count
+
+	y := other.sum - other.c - a.c
+	t := a.sum + y
+	a.c = (t - a.sum) - y
+	a.sum = t
+}
+
+// Reset 清空聚合器的所有状态
+func (a *Aggregator[T]) Reset() {
+	*a = Aggregator[T]{}
+}
+
+// Count 返回已添加的元素个数
+func (a *Aggregator[T]) Count() int {
+	return a.count
+}
+
+// Sum 返回已添加元素的总和
+// 使用 float64 进行 Kahan 补偿求和，不会发生整数溢出
+func (a *Aggregator[T]) Sum() float64 {
+	return a.sum
+}
+
+// Avg 返回已添加元素的平均值，没有元素时返回 false
+func (a *Aggregator[T]) Avg() (float64, bool) {
+	if a.count == 0 {
+		return 0, false
+	}
+	return a.mean, true
+}
+
+// Variance 返回已添加元素的总体方差，没有元素时返回 false
+func (a *Aggregator[T]) Variance() (float64, bool) {
+	if a.count == 0 {
+		return 0, false
+	}
+	return a.m2 / float64(a.count), true
+}
+
+// StdDev 返回已添加元素的总体标准差，没有元素时返回 false
+func (a *Aggregator[T]) StdDev() (float64, bool) {
+	variance, ok := a.Variance()
+	return math.Sqrt(variance), ok
+}
+
+// Min 返回已添加元素的最小值，没有元素时返回 false
+func (a *Aggregator[T]) Min() (T, bool) {
+	return a.min, a.count > 0
+}
+
+// Max 返回已添加元素的最大值，没有元素时返回 false
+func (a *Aggregator[T]) Max() (T, bool) {
+	return a.max, a.count > 0
+}
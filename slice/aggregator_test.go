@@ -0,0 +1,76 @@
+/**
+ * Description：
+ * FileName：aggregator_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 14:57:29
+ * Remark：
+ */
+
+package slice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAggregator(t *testing.T) {
+	var agg Aggregator[int]
+
+	_, ok := agg.Avg()
+	assert.False(t, ok)
+	_, ok = agg.Min()
+	assert.False(t, ok)
+	_, ok = agg.StdDev()
+	assert.False(t, ok)
+
+	agg.Add(2, 4, 4, 4)
+	agg.Add(5, 5, 7, 9)
+
+	assert.Equal(t, 8, agg.Count())
+	assert.Equal(t, float64(40), agg.Sum())
+	avg, ok := agg.Avg()
+	assert.True(t, ok)
+	assert.Equal(t, float64(5), avg)
+	variance, _ := agg.Variance()
+	assert.InDelta(t, 4, variance, 1e-9)
+	stdDev, _ := agg.StdDev()
+	assert.InDelta(t, 2, stdDev, 1e-9)
+	minVal, _ := agg.Min()
+	assert.Equal(t, 2, minVal)
+	maxVal, _ := agg.Max()
+	assert.Equal(t, 9, maxVal)
+
+	agg.Reset()
+	assert.Equal(t, 0, agg.Count())
+	_, ok = agg.Max()
+	assert.False(t, ok)
+}
+
+func TestAggregatorMerge(t *testing.T) {
+	input := []float64{-3, 2, 4, 4, 4, 5, 5, 7, 9, 12.5}
+
+	var all Aggregator[float64]
+	all.Add(input...)
+
+	var left, right, empty Aggregator[float64]
+	left.Add(input[:3]...)
+	right.Add(input[3:]...)
+	left.Merge(&right)
+	left.Merge(&empty)
+
+	assert.Equal(t, all.Count(), left.Count())
+	assert.InDelta(t, all.Sum(), left.Sum(), 1e-9)
+	allAvg, _ := all.Avg()
+	avg, _ := left.Avg()
+	assert.InDelta(t, allAvg, avg, 1e-9)
+	allVariance, _ := all.Variance()
+	variance, _ := left.Variance()
+	assert.InDelta(t, allVariance, variance, 1e-9)
+	minVal, _ := left.Min()
+	assert.Equal(t, float64(-3), minVal)
+	maxVal, _ := left.Max()
+	assert.Equal(t, 12.5, maxVal)
+
+	empty.Merge(&all)
+	assert.Equal(t, all, empty)
+}
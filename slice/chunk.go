@@ -0,0 +1,64 @@
+/**
+ * Description：
+ * FileName：chunk.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 09:12:36
+ * Remark：
+ */
+
+package slice
+
+// Chunk 将切片按照固定大小 n 切分为多个子切片
+// 最后一个子切片的长度可能小于 n
+// 注意:
+// - 子切片与 src 共享底层数组，但容量被截断，对子切片 append 不会覆盖 src 的后续元素
+// - 即使输入为 nil，也保证返回非 nil 的空切片
+// - n <= 0 时会 panic
+// 参数:
+// 输入切片
+// 每个子切片的最大长度
+// 返回值:
+// 切分后的子切片集合
+func Chunk[T any](src []T, n int) [][]T {
+	if n <= 0 {
+		panic("分块大小必须大于 0")
+	}
+	res := make([][]T, 0, (len(src)+n-1)/n)
+	for i := 0; i < len(src); i += n {
+		end := i + n
+		if end > len(src) {
+			end = len(src)
+		}
+		res = append(res, src[i:end:end])
+	}
+	return res
+}
+
+// Window 以滑动窗口的方式遍历切片
+// 窗口长度固定为 size，每次向后移动 step 个元素，不足 size 的尾部窗口会被丢弃
+// 注意:
+// - 窗口与 src 共享底层数组，但容量被截断，对窗口 append 不会覆盖 src 的后续元素
+// - 即使输入为 nil，也保证返回非 nil 的空切片
+// - size <= 0 或 step <= 0 时会 panic
+// 参数:
+// 输入切片
+// 窗口长度
+// 窗口移动步长
+// 返回值:
+// 所有完整窗口组成的切片
+func Window[T any](src []T, size, step int) [][]T {
+	if size <= 0 {
+		panic("窗口大小必须大于 0")
+	}
+	if step <= 0 {
+		panic("窗口步长必须大于 0")
+	}
+	if len(src) < size {
+		return make([][]T, 0)
+	}
+	res := make([][]T, 0, (len(src)-size)/step+1)
+	for i := 0; i+size <= len(src); i += step {
+		res = append(res, src[i:i+size:i+size])
+	}
+	return res
+}
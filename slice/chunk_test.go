@@ -0,0 +1,147 @@
+/**
+ * Description：
+ * FileName：chunk_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 09:20:14
+ * Remark：
+ */
+
+package slice
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		n    int
+		want [][]int
+	}{
+		{
+			name: "src nil",
+			n:    2,
+			want: [][]int{},
+		},
+		{
+			name: "src empty",
+			src:  []int{},
+			n:    2,
+			want: [][]int{},
+		},
+		{
+			name: "exact division",
+			src:  []int{1, 2, 3, 4},
+			n:    2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "last chunk shorter",
+			src:  []int{1, 2, 3, 4, 5},
+			n:    2,
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "n larger than length",
+			src:  []int{1, 2, 3},
+			n:    5,
+			want: [][]int{{1, 2, 3}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Chunk(tc.src, tc.n)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestChunkAppendIsolated(t *testing.T) {
+	src := []int{1, 2, 3, 4}
+	res := Chunk(src, 2)
+	_ = append(res[0], 100)
+	assert.Equal(t, []int{1, 2, 3, 4}, src)
+}
+
+func TestChunkPanic(t *testing.T) {
+	assert.Panics(t, func() {
+		Chunk([]int{1, 2}, 0)
+	})
+}
+
+func TestWindow(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		size int
+		step int
+		want [][]int
+	}{
+		{
+			name: "src nil",
+			size: 2,
+			step: 1,
+			want: [][]int{},
+		},
+		{
+			name: "size larger than length",
+			src:  []int{1, 2},
+			size: 3,
+			step: 1,
+			want: [][]int{},
+		},
+		{
+			name: "step 1",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			step: 1,
+			want: [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name: "step equals size",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			step: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "drop incomplete tail",
+			src:  []int{1, 2, 3, 4, 5, 6},
+			size: 3,
+			step: 2,
+			want: [][]int{{1, 2, 3}, {3, 4, 5}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Window(tc.src, tc.size, tc.step)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestWindowPanic(t *testing.T) {
+	assert.Panics(t, func() {
+		Window([]int{1, 2}, 0, 1)
+	})
+	assert.Panics(t, func() {
+		Window([]int{1, 2}, 1, 0)
+	})
+}
+
+func ExampleChunk() {
+	res := Chunk([]int{1, 2, 3, 4, 5}, 2)
+	fmt.Println(res)
+	// Output:
+	// [[1 2] [3 4] [5]]
+}
+
+func ExampleWindow() {
+	res := Window([]int{1, 2, 3, 4}, 3, 1)
+	fmt.Println(res)
+	// Output:
+	// [[1 2 3] [2 3 4]]
+}
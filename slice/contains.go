@@ -133,3 +133,56 @@ func ContainsAllFunc[T any](src, dst []T, equal equalFunc[T]) bool {
 	}
 	return true
 }
+
+// ContainsAnyBy 判断 src 里面是否存在 dst 中的任何一个元素
+// 使用键函数判断元素是否相等，键相同即视为相等
+// 时间复杂度为 O(n+m)，当元素类型不满足 comparable 时应优先于 ContainsAnyFunc 使用
+// 参数：
+// 源切片，用于搜索的切片
+// 目标切片，包含需要查找的元素
+// 从元素中提取键的函数
+// 返回值：
+// 如果源切片中包含目标切片中的至少一个元素（根据键判断），则返回 true；否则返回 false
+func ContainsAnyBy[T any, K comparable](src, dst []T, key func(src T) K) bool {
+	// 处理空切片情况
+	if len(src) == 0 || len(dst) == 0 {
+		return false
+	}
+
+	srcMap := toKeyMap[T, K](src, key)
+	for _, v := range dst {
+		if _, exist := srcMap[key(v)]; exist {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAllBy 判断 src 里面是否存在 dst 中的所有元素
+// 使用键函数判断元素是否相等，键相同即视为相等
+// 时间复杂度为 O(n+m)，当元素类型不满足 comparable 时应优先于 ContainsAllFunc 使用
+// 参数：
+// 源切片，用于搜索的切片
+// 目标切片，包含需要查找的元素
+// 从元素中提取键的函数
+// 返回值：
+// 如果源切片包含目标切片中的所有元素（根据键判断），则返回 true；否则返回 false
+func ContainsAllBy[T any, K comparable](src, dst []T, key func(src T) K) bool {
+	// 空目标切片总是返回 true
+	if len(dst) == 0 {
+		return true
+	}
+
+	// 源切片为空但目标切片非空时返回 false
+	if len(src) == 0 {
+		return false
+	}
+
+	srcMap := toKeyMap[T, K](src, key)
+	for _, v := range dst {
+		if _, exist := srcMap[key(v)]; !exist {
+			return false
+		}
+	}
+	return true
+}
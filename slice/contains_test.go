@@ -274,3 +274,77 @@ func TestContainsAllFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsAnyBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []setUser
+		dst  []setUser
+		want bool
+	}{
+		{
+			name: "src nil",
+			dst:  []setUser{{1, "a"}},
+			want: false,
+		},
+		{
+			name: "dst nil",
+			src:  []setUser{{1, "a"}},
+			want: false,
+		},
+		{
+			name: "contains by key",
+			src:  []setUser{{1, "a"}, {2, "b"}},
+			dst:  []setUser{{3, "c"}, {2, "other"}},
+			want: true,
+		},
+		{
+			name: "not contains",
+			src:  []setUser{{1, "a"}},
+			dst:  []setUser{{2, "a"}},
+			want: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ContainsAnyBy(tc.src, tc.dst, setUserID))
+		})
+	}
+}
+
+func TestContainsAllBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []setUser
+		dst  []setUser
+		want bool
+	}{
+		{
+			name: "dst nil",
+			src:  []setUser{{1, "a"}},
+			want: true,
+		},
+		{
+			name: "src nil",
+			dst:  []setUser{{1, "a"}},
+			want: false,
+		},
+		{
+			name: "contains all by key",
+			src:  []setUser{{1, "a"}, {2, "b"}, {3, "c"}},
+			dst:  []setUser{{3, "other"}, {1, "other"}, {1, "dup"}},
+			want: true,
+		},
+		{
+			name: "missing one",
+			src:  []setUser{{1, "a"}, {2, "b"}},
+			dst:  []setUser{{1, "a"}, {3, "c"}},
+			want: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ContainsAllBy(tc.src, tc.dst, setUserID))
+		})
+	}
+}
@@ -8,7 +8,10 @@
 
 package slice
 
-import "github.com/carefuly/careful-echo/internal/slice"
+import (
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/internal/slice"
+)
 
 // Delete 从切片中删除指定索引位置的元素
 // 参数：
@@ -61,3 +64,53 @@ func FilterDelete[T any](src []T, m func(idx int, src T) bool) []T {
 
 	return src[:emptyPos]
 }
+
+// DeleteRange 原地删除切片中 [from, to) 区间的元素
+// 不会分配内存，被移出有效长度的尾部元素会被置为零值，避免引用的对象无法被回收
+// 参数：
+// src: 原始切片（函数会直接修改此切片）
+// from: 删除区间的起始索引（包含）
+// to: 删除区间的结束索引（不包含）
+// 返回值：
+// 删除元素后的切片（与原切片共享底层数组）
+// 错误信息（索引越界或 from > to 时返回错误）
+func DeleteRange[T any](src []T, from, to int) ([]T, error) {
+	length := len(src)
+	if from < 0 || from > length {
+		return nil, errs.NewErrIndexOutOfRange(length, from)
+	}
+	if to < from || to > length {
+		return nil, errs.NewErrIndexOutOfRange(length, to)
+	}
+	if from == to {
+		return src, nil
+	}
+
+	copy(src[from:], src[to:])
+	newLen := length - (to - from)
+	clear(src[newLen:length])
+	return src[:newLen], nil
+}
+
+// RetainIf 原地保留切片中满足条件的元素，是 FilterDelete 的反向操作
+// 保留元素的相对顺序不变，不会分配内存
+// 被移出有效长度的尾部元素会被置为零值，避免引用的对象无法被回收
+// 参数：
+// src: 原始切片（函数会直接修改此切片）
+// match: 条件判断函数，返回 true 表示保留该元素
+// 返回值：
+// 只包含保留元素的切片（与原切片共享底层数组）
+func RetainIf[T any](src []T, match func(idx int, src T) bool) []T {
+	pos := 0
+	for i := 0; i < len(src); i++ {
+		if !match(i, src[i]) {
+			continue
+		}
+		if pos != i {
+			src[pos] = src[i]
+		}
+		pos++
+	}
+	clear(src[pos:])
+	return src[:pos]
+}
@@ -174,3 +174,130 @@ func TestFilterDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRange(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		from      int
+		to        int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "delete head",
+			slice:     []int{1, 2, 3, 4},
+			from:      0,
+			to:        2,
+			wantSlice: []int{3, 4},
+		},
+		{
+			name:      "delete middle",
+			slice:     []int{1, 2, 3, 4},
+			from:      1,
+			to:        3,
+			wantSlice: []int{1, 4},
+		},
+		{
+			name:      "delete tail",
+			slice:     []int{1, 2, 3, 4},
+			from:      2,
+			to:        4,
+			wantSlice: []int{1, 2},
+		},
+		{
+			name:      "empty range",
+			slice:     []int{1, 2, 3, 4},
+			from:      2,
+			to:        2,
+			wantSlice: []int{1, 2, 3, 4},
+		},
+		{
+			name:    "from out of range",
+			slice:   []int{1, 2},
+			from:    3,
+			to:      3,
+			wantErr: errs.NewErrIndexOutOfRange(2, 3),
+		},
+		{
+			name:    "to out of range",
+			slice:   []int{1, 2},
+			from:    0,
+			to:      3,
+			wantErr: errs.NewErrIndexOutOfRange(2, 3),
+		},
+		{
+			name:    "from greater than to",
+			slice:   []int{1, 2},
+			from:    2,
+			to:      1,
+			wantErr: errs.NewErrIndexOutOfRange(2, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DeleteRange(tc.slice, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestDeleteRangeClearTail(t *testing.T) {
+	src := []*int{new(int), new(int), new(int)}
+	res, err := DeleteRange(src, 0, 2)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, []*int{nil, nil}, src[1:])
+}
+
+func TestRetainIf(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "retain all",
+			slice:     []int{2, 4},
+			wantSlice: []int{2, 4},
+		},
+		{
+			name:      "retain none",
+			slice:     []int{1, 3},
+			wantSlice: []int{},
+		},
+		{
+			name:      "stable",
+			slice:     []int{1, 2, 3, 4, 5, 6},
+			wantSlice: []int{2, 4, 6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := RetainIf(tc.slice, func(idx int, src int) bool {
+				return src%2 == 0
+			})
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestDeleteAllocs(t *testing.T) {
+	src := make([]int, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = DeleteRange(src, 10, 20)
+		_ = RetainIf(src, func(idx int, src int) bool {
+			return idx%2 == 0
+		})
+	})
+	assert.Equal(t, float64(0), allocs)
+}
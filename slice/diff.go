@@ -39,6 +39,7 @@ func DiffSet[T comparable](src, dst []T) []T {
 // 结果已去重
 // 返回顺序为源切片中第一次出现的顺序
 // 适用于任意类型（any）
+// 时间复杂度为 O(n·m)，能够提取 comparable 键时应优先使用 DiffSetBy
 // 参数：
 // 源切片，作为被减数
 // 目标切片，作为减数
@@ -58,3 +59,29 @@ func DiffSetFunc[T any](src, dst []T, equal equalFunc[T]) []T {
 
 	return deduplicateFunc[T](ret, equal)
 }
+
+// DiffSetBy 使用键函数计算两个切片的差集（src - dst）
+// 键相同即视为相等，时间复杂度为 O(n+m)
+// 结果已去重
+// 返回顺序与 DiffSetFunc 相同，为源切片中第一次出现的顺序
+// 参数：
+// 源切片，作为被减数
+// 目标切片，作为减数
+// 从元素中提取键的函数
+// 返回值：
+// 差集切片，包含所有在 src 中但不在 dst 中的元素
+// 当 src 为空时返回空切片
+func DiffSetBy[T any, K comparable](src, dst []T, key func(src T) K) []T {
+	dstMap := toKeyMap[T, K](dst, key)
+	var ret = make([]T, 0, len(src))
+	for _, v := range src {
+		k := key(v)
+		if _, exist := dstMap[k]; exist {
+			continue
+		}
+		// 加入 dstMap 中，后续相同键的元素会被当作已存在而跳过，达到去重的目的
+		dstMap[k] = struct{}{}
+		ret = append(ret, v)
+	}
+	return ret
+}
@@ -96,3 +96,34 @@ func TestDiffSetFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffSetBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []setUser
+		dst  []setUser
+		want []setUser
+	}{
+		{
+			name: "src nil",
+			dst:  []setUser{{1, "a"}},
+			want: []setUser{},
+		},
+		{
+			name: "src order and first occurrence",
+			src:  []setUser{{4, "src-4"}, {1, "src-1"}, {2, "src-2"}, {4, "src-4-dup"}},
+			dst:  []setUser{{1, "dst-1"}, {3, "dst-3"}},
+			want: []setUser{{4, "src-4"}, {2, "src-2"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := DiffSetBy(tc.src, tc.dst, setUserID)
+			assert.Equal(t, tc.want, res)
+			// 与 DiffSetFunc 的顺序保证一致
+			if len(tc.want) > 0 {
+				assert.Equal(t, tc.want, DiffSetFunc(tc.src, tc.dst, setUserEqual))
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/**
+ * Description：
+ * FileName：group.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 09:31:08
+ * Remark：
+ */
+
+package slice
+
+// Partition 按照匹配函数将切片拆分为两部分
+// 两部分内元素的相对顺序与 src 保持一致
+// 即使输入为 nil，也保证两个返回值都是非 nil 的空切片
+// 参数:
+// 输入切片
+// 匹配函数，接收索引和元素，返回是否归入第一部分
+// 返回值:
+// 满足条件的元素
+// 不满足条件的元素
+func Partition[T any](src []T, match func(idx int, src T) bool) ([]T, []T) {
+	matched := make([]T, 0, len(src))
+	unmatched := make([]T, 0, len(src))
+	for i := 0; i < len(src); i++ {
+		if match(i, src[i]) {
+			matched = append(matched, src[i])
+		} else {
+			unmatched = append(unmatched, src[i])
+		}
+	}
+	return matched, unmatched
+}
+
+// GroupBy 按照键函数对切片元素进行分组
+// 每个分组内元素的相对顺序与 src 保持一致
+// 即使输入为 nil，也保证返回非 nil 的空映射
+// 参数:
+// 输入切片
+// 键函数，接收索引和元素，返回分组键
+// 返回值:
+// 分组结果，键为 keyFn 返回的值，值为该分组的元素
+func GroupBy[T any, K comparable](src []T, keyFn func(idx int, src T) K) map[K][]T {
+	res := make(map[K][]T)
+	for i := 0; i < len(src); i++ {
+		key := keyFn(i, src[i])
+		res[key] = append(res[key], src[i])
+	}
+	return res
+}
+
+// CountBy 按照键函数统计每个分组的元素个数
+// 即使输入为 nil，也保证返回非 nil 的空映射
+// 参数:
+// 输入切片
+// 键函数，接收索引和元素，返回分组键
+// 返回值:
+// 统计结果，键为 keyFn 返回的值，值为该分组的元素个数
+func CountBy[T any, K comparable](src []T, keyFn func(idx int, src T) K) map[K]int {
+	res := make(map[K]int)
+	for i := 0; i < len(src); i++ {
+		res[keyFn(i, src[i])]++
+	}
+	return res
+}
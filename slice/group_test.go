@@ -0,0 +1,106 @@
+/**
+ * Description：
+ * FileName：group_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 09:40:27
+ * Remark：
+ */
+
+package slice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPartition(t *testing.T) {
+	testCases := []struct {
+		name          string
+		src           []int
+		wantMatched   []int
+		wantUnmatched []int
+	}{
+		{
+			name:          "src nil",
+			wantMatched:   []int{},
+			wantUnmatched: []int{},
+		},
+		{
+			name:          "all matched",
+			src:           []int{2, 4},
+			wantMatched:   []int{2, 4},
+			wantUnmatched: []int{},
+		},
+		{
+			name:          "mixed",
+			src:           []int{1, 2, 3, 4, 5},
+			wantMatched:   []int{2, 4},
+			wantUnmatched: []int{1, 3, 5},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, unmatched := Partition(tc.src, func(idx int, src int) bool {
+				return src%2 == 0
+			})
+			assert.Equal(t, tc.wantMatched, matched)
+			assert.Equal(t, tc.wantUnmatched, unmatched)
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []string
+		want map[int][]string
+	}{
+		{
+			name: "src nil",
+			want: map[int][]string{},
+		},
+		{
+			name: "group by length",
+			src:  []string{"a", "bb", "c", "dd", "eee"},
+			want: map[int][]string{
+				1: {"a", "c"},
+				2: {"bb", "dd"},
+				3: {"eee"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := GroupBy(tc.src, func(idx int, src string) int {
+				return len(src)
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want map[bool]int
+	}{
+		{
+			name: "src nil",
+			want: map[bool]int{},
+		},
+		{
+			name: "count even and odd",
+			src:  []int{1, 2, 3, 4, 5},
+			want: map[bool]int{true: 2, false: 3},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := CountBy(tc.src, func(idx int, src int) bool {
+				return src%2 == 0
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
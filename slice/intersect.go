@@ -9,6 +9,7 @@
 package slice
 
 // IntersectSet 计算两个切片的交集（只支持 comparable 类型）
+// 返回顺序不确定
 // 参数：
 // 第一个切片
 // 第二个切片
@@ -27,6 +28,8 @@ func IntersectSet[T comparable](src []T, dst []T) []T {
 }
 
 // IntersectSetFunc 使用自定义相等函数计算两个切片的交集（支持任意类型）
+// 返回顺序为 dst 中第一次出现的顺序，重复元素保留 dst 中第一次出现的那个
+// 时间复杂度为 O(n·m)，能够提取 comparable 键时应优先使用 IntersectSetBy
 // 参数：
 // 第一个切片
 // 第二个切片
@@ -44,3 +47,30 @@ func IntersectSetFunc[T any](src []T, dst []T, equal equalFunc[T]) []T {
 	}
 	return deduplicateFunc[T](ret, equal)
 }
+
+// IntersectSetBy 使用键函数计算两个切片的交集（支持任意类型）
+// 键相同即视为相等，时间复杂度为 O(n+m)
+// 返回顺序与 IntersectSetFunc 相同，为 dst 中第一次出现的顺序，重复元素保留 dst 中第一次出现的那个
+// 参数：
+// 第一个切片
+// 第二个切片
+// 从元素中提取键的函数
+// 返回值：
+// 交集切片（已去重）
+func IntersectSetBy[T any, K comparable](src []T, dst []T, key func(src T) K) []T {
+	srcMap := toKeyMap[T, K](src, key)
+	seen := make(map[K]struct{}, len(dst))
+	var ret = make([]T, 0, len(dst))
+	for _, v := range dst {
+		k := key(v)
+		if _, exist := srcMap[k]; !exist {
+			continue
+		}
+		if _, exist := seen[k]; exist {
+			continue
+		}
+		seen[k] = struct{}{}
+		ret = append(ret, v)
+	}
+	return ret
+}
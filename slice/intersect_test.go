@@ -130,3 +130,48 @@ func TestIntersectSetFunc(t *testing.T) {
 		})
 	}
 }
+
+// setUser 用于测试 *By 系列函数，id 相同即视为同一个元素
+type setUser struct {
+	id   int
+	name string
+}
+
+func setUserID(u setUser) int {
+	return u.id
+}
+
+func setUserEqual(src, dst setUser) bool {
+	return src.id == dst.id
+}
+
+func TestIntersectSetBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []setUser
+		dst  []setUser
+		want []setUser
+	}{
+		{
+			name: "src nil",
+			dst:  []setUser{{1, "a"}},
+			want: []setUser{},
+		},
+		{
+			name: "dst order and first occurrence",
+			src:  []setUser{{1, "src-1"}, {2, "src-2"}, {3, "src-3"}},
+			dst:  []setUser{{3, "dst-3"}, {4, "dst-4"}, {1, "dst-1"}, {3, "dst-3-dup"}},
+			want: []setUser{{3, "dst-3"}, {1, "dst-1"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := IntersectSetBy(tc.src, tc.dst, setUserID)
+			assert.Equal(t, tc.want, res)
+			// 与 IntersectSetFunc 的顺序保证一致
+			if len(tc.want) > 0 {
+				assert.Equal(t, tc.want, IntersectSetFunc(tc.src, tc.dst, setUserEqual))
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@
 
 package slice
 
+import "errors"
+
 // FilterMap 对切片元素进行过滤和转换
 // 对每个元素调用映射函数 m，仅当 m 返回 true 时，将转换结果加入返回切片
 // 即使某些元素被过滤，也会遍历所有元素
@@ -44,6 +46,88 @@ func Map[Src any, Dst any](src []Src, m func(idx int, src Src) Dst) []Dst {
 	return dst
 }
 
+// MapErr 将切片元素转换为新类型，转换可能失败
+// 遇到第一个错误时立即停止，返回 nil 和该错误
+// 参数:
+// 输入切片
+// 映射函数，接收索引和元素，返回转换后的元素或错误
+// 返回值:
+// 转换后的新切片，长度与输入相同
+// 第一个错误
+func MapErr[Src any, Dst any](src []Src, m func(idx int, src Src) (Dst, error)) ([]Dst, error) {
+	dst := make([]Dst, len(src))
+	for i := 0; i < len(src); i++ {
+		val, err := m(i, src[i])
+		if err != nil {
+			return nil, err
+		}
+		dst[i] = val
+	}
+	return dst, nil
+}
+
+// MapErrAll 与 MapErr 类似，但是遇到错误时继续处理剩余的元素
+// 存在错误时返回 nil 和使用 errors.Join 按顺序合并的所有错误
+func MapErrAll[Src any, Dst any](src []Src, m func(idx int, src Src) (Dst, error)) ([]Dst, error) {
+	dst := make([]Dst, len(src))
+	var errList []error
+	for i := 0; i < len(src); i++ {
+		val, err := m(i, src[i])
+		if err != nil {
+			errList = append(errList, err)
+			continue
+		}
+		dst[i] = val
+	}
+	if len(errList) > 0 {
+		return nil, errors.Join(errList...)
+	}
+	return dst, nil
+}
+
+// FilterMapErr 对切片元素进行过滤和转换，转换可能失败
+// 仅当 m 返回 true 时，将转换结果加入返回切片；遇到第一个错误时立即停止，返回 nil 和该错误
+// 参数:
+// 输入切片
+// 映射函数，接收索引和元素，返回转换后的元素、是否保留以及错误
+// 返回值:
+// 过滤并转换后的新切片
+// 第一个错误
+func FilterMapErr[Src any, Dst any](src []Src, m func(idx int, src Src) (Dst, bool, error)) ([]Dst, error) {
+	res := make([]Dst, 0, len(src))
+	for i := 0; i < len(src); i++ {
+		dst, ok, err := m(i, src[i])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, dst)
+		}
+	}
+	return res, nil
+}
+
+// FilterMapErrAll 与 FilterMapErr 类似，但是遇到错误时继续处理剩余的元素
+// 存在错误时返回 nil 和使用 errors.Join 按顺序合并的所有错误
+func FilterMapErrAll[Src any, Dst any](src []Src, m func(idx int, src Src) (Dst, bool, error)) ([]Dst, error) {
+	res := make([]Dst, 0, len(src))
+	var errList []error
+	for i := 0; i < len(src); i++ {
+		dst, ok, err := m(i, src[i])
+		if err != nil {
+			errList = append(errList, err)
+			continue
+		}
+		if ok {
+			res = append(res, dst)
+		}
+	}
+	if len(errList) > 0 {
+		return nil, errors.Join(errList...)
+	}
+	return res, nil
+}
+
 // ToMap 将切片转换为映射 [Key]Ele
 // 使用提供的函数从元素中提取键
 // 注意:
@@ -106,6 +190,20 @@ func toMap[T comparable](src []T) map[T]struct{} {
 	return dataMap
 }
 
+// toKeyMap 使用键函数将切片转换为键的集合，用于高效查找
+// 参数：
+// 需要转换的切片
+// 从元素中提取键的函数
+// 返回值：
+// 包含所有键的 map，值使用空结构体以减少内存占用
+func toKeyMap[T any, K comparable](src []T, key func(src T) K) map[K]struct{} {
+	var dataMap = make(map[K]struct{}, len(src))
+	for _, v := range src {
+		dataMap[key(v)] = struct{}{}
+	}
+	return dataMap
+}
+
 // deduplicate 对切片进行去重处理，返回不包含重复元素的新切片
 // 参数：
 // 待去重的原始切片
@@ -9,6 +9,8 @@
 package slice
 
 import (
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"strconv"
 	"testing"
@@ -278,3 +280,114 @@ func TestToMapV(t *testing.T) {
 		assert.Equal(t, epeMap, resMap)
 	})
 }
+
+func TestMapErr(t *testing.T) {
+	testCases := []struct {
+		name        string
+		src         []string
+		want        []int
+		wantAll     []int
+		wantErr     string
+		wantAllErr  string
+		wantVisited int
+	}{
+		{
+			name:    "src nil",
+			want:    []int{},
+			wantAll: []int{},
+		},
+		{
+			name:        "all ok",
+			src:         []string{"1", "2", "3"},
+			want:        []int{1, 2, 3},
+			wantAll:     []int{1, 2, 3},
+			wantVisited: 3,
+		},
+		{
+			name:        "has error",
+			src:         []string{"1", "a", "3", "b"},
+			wantErr:     "下标 1: a",
+			wantAllErr:  "下标 1: a\n下标 3: b",
+			wantVisited: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			visited := 0
+			m := func(idx int, src string) (int, error) {
+				visited++
+				val, err := strconv.Atoi(src)
+				if err != nil {
+					return 0, fmt.Errorf("下标 %d: %s", idx, src)
+				}
+				return val, nil
+			}
+			res, err := MapErr(tc.src, m)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.wantVisited, visited)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			res, err = MapErrAll(tc.src, m)
+			assert.Equal(t, tc.wantAll, res)
+			if tc.wantAllErr != "" {
+				assert.EqualError(t, err, tc.wantAllErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFilterMapErr(t *testing.T) {
+	errNegative := errors.New("negative")
+	testCases := []struct {
+		name       string
+		src        []int
+		want       []string
+		wantAll    []string
+		wantErr    error
+		wantAllErr error
+	}{
+		{
+			name:    "src nil",
+			want:    []string{},
+			wantAll: []string{},
+		},
+		{
+			name:    "filter odd",
+			src:     []int{1, 2, 3, 4},
+			want:    []string{"2", "4"},
+			wantAll: []string{"2", "4"},
+		},
+		{
+			name:       "has error",
+			src:        []int{2, -1, 4, -3},
+			wantErr:    errNegative,
+			wantAllErr: errors.Join(errNegative, errNegative),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := func(idx int, src int) (string, bool, error) {
+				if src < 0 {
+					return "", false, errNegative
+				}
+				return strconv.Itoa(src), src%2 == 0, nil
+			}
+			res, err := FilterMapErr(tc.src, m)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.wantErr, err)
+
+			res, err = FilterMapErrAll(tc.src, m)
+			assert.Equal(t, tc.wantAll, res)
+			assert.Equal(t, tc.wantAllErr, err)
+			if tc.wantAllErr != nil {
+				assert.ErrorIs(t, err, errNegative)
+			}
+		})
+	}
+}
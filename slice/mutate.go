@@ -0,0 +1,134 @@
+/**
+ * Description：
+ * FileName：mutate.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 15:42:17
+ * Remark：
+ */
+
+package slice
+
+import "github.com/carefuly/careful-echo/internal/errs"
+
+// 本文件中的函数都会直接修改传入的切片，除出错时构造 error 外不会分配内存
+
+// Swap 原地交换切片中两个位置的元素
+// 参数:
+// 需要操作的切片
+// 第一个元素的索引
+// 第二个元素的索引
+// 返回值:
+// 错误信息（索引越界时返回错误）
+func Swap[T any](src []T, i, j int) error {
+	length := len(src)
+	if i < 0 || i >= length {
+		return errs.NewErrIndexOutOfRange(length, i)
+	}
+	if j < 0 || j >= length {
+		return errs.NewErrIndexOutOfRange(length, j)
+	}
+	src[i], src[j] = src[j], src[i]
+	return nil
+}
+
+// Move 原地将 from 位置的元素移动到 to 位置
+// 两者之间的元素依次平移一位，其余元素位置不变
+// 例如 [a b c d] 将 0 移动到 2 后为 [b c a d]
+// 参数:
+// 需要操作的切片
+// 元素原来的索引
+// 元素的目标索引
+// 返回值:
+// 错误信息（索引越界时返回错误）
+func Move[T any](src []T, from, to int) error {
+	length := len(src)
+	if from < 0 || from >= length {
+		return errs.NewErrIndexOutOfRange(length, from)
+	}
+	if to < 0 || to >= length {
+		return errs.NewErrIndexOutOfRange(length, to)
+	}
+
+	val := src[from]
+	if from < to {
+		// (from, to] 区间整体前移一位
+		copy(src[from:to], src[from+1:to+1])
+	} else {
+		// [to, from) 区间整体后移一位
+		copy(src[to+1:from+1], src[to:from])
+	}
+	src[to] = val
+	return nil
+}
+
+// Rotate 原地将切片循环左移 k 位
+// k 为负数时表示循环右移，k 可以大于切片长度
+// 例如 [1 2 3 4 5] 左移 2 位后为 [3 4 5 1 2]
+// 参数:
+// 需要操作的切片
+// 左移的位数
+func Rotate[T any](src []T, k int) {
+	length := len(src)
+	if length == 0 {
+		return
+	}
+	k %= length
+	if k < 0 {
+		k += length
+	}
+	if k == 0 {
+		return
+	}
+	// 三次反转：先分别反转两段，再整体反转
+	ReverseSelf[T](src[:k])
+	ReverseSelf[T](src[k:])
+	ReverseSelf[T](src)
+}
+
+// Fill 原地将切片的所有元素设置为 val
+// 参数:
+// 需要操作的切片
+// 填充的值
+func Fill[T any](src []T, val T) {
+	for i := range src {
+		src[i] = val
+	}
+}
+
+// Compact 原地移除切片中连续重复的元素，只保留每组的第一个
+// 如果需要移除所有重复元素，应先对切片排序
+// 被移出有效长度的尾部元素会被置为零值，避免引用的对象无法被回收
+// 参数:
+// 需要操作的切片
+// 返回值:
+// 移除后的切片（与原切片共享底层数组）
+func Compact[T comparable](src []T) []T {
+	return CompactFunc[T](src, func(src, dst T) bool {
+		return src == dst
+	})
+}
+
+// CompactFunc 原地移除切片中连续重复的元素，使用自定义相等函数判断是否重复
+// 被移出有效长度的尾部元素会被置为零值，避免引用的对象无法被回收
+// 参数:
+// 需要操作的切片
+// 元素相等性判断函数
+// 返回值:
+// 移除后的切片（与原切片共享底层数组）
+func CompactFunc[T any](src []T, equal equalFunc[T]) []T {
+	if len(src) < 2 {
+		return src
+	}
+	pos := 1
+	for i := 1; i < len(src); i++ {
+		if equal(src[pos-1], src[i]) {
+			continue
+		}
+		if pos != i {
+			src[pos] = src[i]
+		}
+		pos++
+	}
+	clear(src[pos:])
+	return src[:pos]
+}
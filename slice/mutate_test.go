@@ -0,0 +1,236 @@
+/**
+ * Description：
+ * FileName：mutate_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 16:03:52
+ * Remark：
+ */
+
+package slice
+
+import (
+	"fmt"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSwap(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		i         int
+		j         int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "swap",
+			slice:     []int{1, 2, 3},
+			i:         0,
+			j:         2,
+			wantSlice: []int{3, 2, 1},
+		},
+		{
+			name:      "same index",
+			slice:     []int{1, 2, 3},
+			i:         1,
+			j:         1,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:    "i out of range",
+			slice:   []int{1, 2, 3},
+			i:       3,
+			j:       0,
+			wantErr: errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:    "j less than 0",
+			slice:   []int{1, 2, 3},
+			i:       0,
+			j:       -1,
+			wantErr: errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Swap(tc.slice, tc.i, tc.j)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
+
+func TestMove(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []string
+		from      int
+		to        int
+		wantSlice []string
+		wantErr   error
+	}{
+		{
+			name:      "move forward",
+			slice:     []string{"a", "b", "c", "d"},
+			from:      0,
+			to:        2,
+			wantSlice: []string{"b", "c", "a", "d"},
+		},
+		{
+			name:      "move backward",
+			slice:     []string{"a", "b", "c", "d"},
+			from:      3,
+			to:        1,
+			wantSlice: []string{"a", "d", "b", "c"},
+		},
+		{
+			name:      "same index",
+			slice:     []string{"a", "b"},
+			from:      1,
+			to:        1,
+			wantSlice: []string{"a", "b"},
+		},
+		{
+			name:    "from out of range",
+			slice:   []string{"a", "b"},
+			from:    2,
+			to:      0,
+			wantErr: errs.NewErrIndexOutOfRange(2, 2),
+		},
+		{
+			name:    "to out of range",
+			slice:   []string{"a", "b"},
+			from:    0,
+			to:      2,
+			wantErr: errs.NewErrIndexOutOfRange(2, 2),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Move(tc.slice, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		k         int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+			k:    3,
+		},
+		{
+			name:      "rotate left",
+			slice:     []int{1, 2, 3, 4, 5},
+			k:         2,
+			wantSlice: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:      "rotate right",
+			slice:     []int{1, 2, 3, 4, 5},
+			k:         -1,
+			wantSlice: []int{5, 1, 2, 3, 4},
+		},
+		{
+			name:      "k larger than length",
+			slice:     []int{1, 2, 3},
+			k:         7,
+			wantSlice: []int{2, 3, 1},
+		},
+		{
+			name:      "k equals length",
+			slice:     []int{1, 2, 3},
+			k:         3,
+			wantSlice: []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Rotate(tc.slice, tc.k)
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
+
+func TestFill(t *testing.T) {
+	src := make([]int, 3)
+	Fill(src, 7)
+	assert.Equal(t, []int{7, 7, 7}, src)
+	Fill[int](nil, 7)
+}
+
+func TestCompact(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "single",
+			slice:     []int{1},
+			wantSlice: []int{1},
+		},
+		{
+			name:      "consecutive duplicates",
+			slice:     []int{1, 1, 2, 3, 3, 3, 1},
+			wantSlice: []int{1, 2, 3, 1},
+		},
+		{
+			name:      "all same",
+			slice:     []int{2, 2, 2},
+			wantSlice: []int{2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Compact(tc.slice)
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	src := []string{"a", "A", "b", "B", "b", "c"}
+	res := CompactFunc(src, strings.EqualFold)
+	assert.Equal(t, []string{"a", "b", "c"}, res)
+	// 尾部元素被置为零值
+	assert.Equal(t, []string{"", "", ""}, src[3:])
+}
+
+func TestMutateAllocs(t *testing.T) {
+	src := []int{1, 1, 2, 3, 3, 4, 5, 6, 7, 8}
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Swap(src, 0, 9)
+		_ = Move(src, 1, 8)
+		Rotate(src, 3)
+		Fill(src[:2], 1)
+		_ = Compact(src)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func ExampleMove() {
+	src := []string{"a", "b", "c", "d"}
+	_ = Move(src, 0, 2)
+	fmt.Println(src)
+	// Output:
+	// [b c a d]
+}
@@ -0,0 +1,96 @@
+/**
+ * Description：
+ * FileName：aggregate.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 12:15:06
+ * Remark：
+ */
+
+package parallel
+
+import (
+	"context"
+	echo "github.com/carefuly/careful-echo"
+	"github.com/carefuly/careful-echo/bean/option"
+)
+
+// Reduce 并发地将切片归约为一个值
+// 每个分块从 init 开始，使用 acc 依次累积本分块的元素，
+// 最后按分块顺序使用 merge 合并各分块的结果
+// 因此 init 必须是 merge 的单位元，merge 必须满足结合律，否则结果会随分块方式变化
+// 参数:
+// 上下文，被取消时停止处理并返回 ctx.Err()
+// 输入切片
+// 初始值
+// 累积函数，接收当前累积值、索引和元素，返回新的累积值
+// 合并函数，合并两个分块的累积值
+// 并行配置，参考 WithWorkers、WithMinChunkSize
+// 返回值:
+// 归约结果，src 为空时返回 init
+// 错误信息
+func Reduce[T any, R any](
+	ctx context.Context,
+	src []T,
+	init R,
+	acc func(res R, idx int, src T) R,
+	merge func(a, b R) R,
+	opts ...option.Option[Config],
+) (R, error) {
+	chunks := newConfig(opts...).chunks(len(src))
+	parts := make([]R, len(chunks))
+	err := run(ctx, chunks, func(chunk int, start, end int, stopped func() bool) error {
+		res := init
+		for i := start; i < end; i++ {
+			if i%checkInterval == 0 && stopped() {
+				return nil
+			}
+			res = acc(res, i, src[i])
+		}
+		parts[chunk] = res
+		return nil
+	})
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+
+	res := init
+	for _, part := range parts {
+		res = merge(res, part)
+	}
+	return res, nil
+}
+
+// Sum 并发地计算切片所有元素的总和，形如 slice.Sum
+// 元素数量较少时并发的调度开销会超过收益，建议配合 WithMinChunkSize 使用
+// 参数:
+// 上下文，被取消时停止处理并返回 ctx.Err()
+// 包含数值的切片
+// 并行配置，参考 WithWorkers、WithMinChunkSize
+// 返回值:
+// 所有元素的总和
+// 错误信息
+func Sum[T echo.Number](ctx context.Context, values []T, opts ...option.Option[Config]) (T, error) {
+	chunks := newConfig(opts...).chunks(len(values))
+	parts := make([]T, len(chunks))
+	// 不复用 Reduce，避免每个元素都经过一次函数值调用
+	err := run(ctx, chunks, func(chunk int, start, end int, stopped func() bool) error {
+		var res T
+		for i := start; i < end; i++ {
+			if i%checkInterval == 0 && stopped() {
+				return nil
+			}
+			res += values[i]
+		}
+		parts[chunk] = res
+		return nil
+	})
+	var res T
+	if err != nil {
+		return res, err
+	}
+	for _, part := range parts {
+		res += part
+	}
+	return res, nil
+}
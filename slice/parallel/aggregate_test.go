@@ -0,0 +1,108 @@
+/**
+ * Description：
+ * FileName：aggregate_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 13:04:18
+ * Remark：
+ */
+
+package parallel
+
+import (
+	"context"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/slice"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		opts []option.Option[Config]
+		want string
+	}{
+		{
+			name: "src nil",
+			want: "",
+		},
+		{
+			// 字符串拼接满足结合律但不满足交换律，可以验证分块的合并顺序
+			name: "keep order",
+			src:  genInts(20),
+			opts: []option.Option[Config]{WithWorkers(6)},
+			want: "012345678910111213141516171819",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Reduce(context.Background(), tc.src, "", func(res string, idx int, src int) string {
+				return res + strconv.Itoa(src)
+			}, func(a, b string) string {
+				return a + b
+			}, tc.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestReducePanic(t *testing.T) {
+	_, err := Reduce(context.Background(), genInts(10), 0, func(res int, idx int, src int) int {
+		panic("boom")
+	}, func(a, b int) int {
+		return a + b
+	})
+	assert.Error(t, err)
+}
+
+func TestSum(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		opts []option.Option[Config]
+		want int
+	}{
+		{
+			name: "src nil",
+		},
+		{
+			name: "values",
+			src:  genInts(10001),
+			opts: []option.Option[Config]{WithWorkers(4)},
+			want: 50005000,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Sum(context.Background(), tc.src, tc.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestSumCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Sum(ctx, genInts(10))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func BenchmarkSum(b *testing.B) {
+	for _, n := range []int{1000, 1000000} {
+		src := genInts(n)
+		b.Run("sequential/"+strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slice.Sum(src)
+			}
+		})
+		b.Run("parallel/"+strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = Sum(context.Background(), src, WithMinChunkSize(64*1024))
+			}
+		})
+	}
+}
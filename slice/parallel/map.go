@@ -0,0 +1,133 @@
+/**
+ * Description：
+ * FileName：map.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 11:52:30
+ * Remark：
+ */
+
+package parallel
+
+import (
+	"context"
+	"github.com/carefuly/careful-echo/bean/option"
+)
+
+// Map 并发地将切片元素转换为新类型，形如 slice.Map
+// 返回结果的顺序与 src 保持一致
+// 任意一个元素返回 error 或发生 panic 时，会尽快停止其余元素的处理并返回第一个 error
+// 参数:
+// 上下文，被取消时停止处理并返回 ctx.Err()
+// 输入切片
+// 映射函数，接收索引和元素，返回转换后的元素
+// 并行配置，参考 WithWorkers、WithMinChunkSize
+// 返回值:
+// 转换后的新切片，长度与输入相同，出错时为 nil
+// 错误信息
+func Map[Src any, Dst any](
+	ctx context.Context,
+	src []Src,
+	m func(idx int, src Src) (Dst, error),
+	opts ...option.Option[Config],
+) ([]Dst, error) {
+	dst := make([]Dst, len(src))
+	// 每个分块只写入自己负责的下标区间，无需加锁
+	err := run(ctx, newConfig(opts...).chunks(len(src)), func(_ int, start, end int, stopped func() bool) error {
+		for i := start; i < end; i++ {
+			if i%checkInterval == 0 && stopped() {
+				return nil
+			}
+			res, err := m(i, src[i])
+			if err != nil {
+				return err
+			}
+			dst[i] = res
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// FilterMap 并发地对切片元素进行过滤和转换，形如 slice.FilterMap
+// 返回结果的顺序与 src 保持一致
+// 任意一个元素返回 error 或发生 panic 时，会尽快停止其余元素的处理并返回第一个 error
+// 参数:
+// 上下文，被取消时停止处理并返回 ctx.Err()
+// 输入切片
+// 映射函数，接收索引和元素，返回转换后的元素和是否保留的布尔值
+// 并行配置，参考 WithWorkers、WithMinChunkSize
+// 返回值:
+// 过滤并转换后的新切片，出错时为 nil
+// 错误信息
+func FilterMap[Src any, Dst any](
+	ctx context.Context,
+	src []Src,
+	m func(idx int, src Src) (Dst, bool, error),
+	opts ...option.Option[Config],
+) ([]Dst, error) {
+	chunks := newConfig(opts...).chunks(len(src))
+	// 每个分块单独收集结果，最后按分块顺序拼接
+	parts := make([][]Dst, len(chunks))
+	err := run(ctx, chunks, func(chunk int, start, end int, stopped func() bool) error {
+		part := make([]Dst, 0, end-start)
+		for i := start; i < end; i++ {
+			if i%checkInterval == 0 && stopped() {
+				return nil
+			}
+			res, ok, err := m(i, src[i])
+			if err != nil {
+				return err
+			}
+			if ok {
+				part = append(part, res)
+			}
+		}
+		parts[chunk] = part
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, part := range parts {
+		total += len(part)
+	}
+	dst := make([]Dst, 0, total)
+	for _, part := range parts {
+		dst = append(dst, part...)
+	}
+	return dst, nil
+}
+
+// ForEach 并发地对切片的每个元素执行 fn
+// 元素的执行顺序不确定
+// 任意一个元素返回 error 或发生 panic 时，会尽快停止其余元素的处理并返回第一个 error
+// 参数:
+// 上下文，被取消时停止处理并返回 ctx.Err()
+// 输入切片
+// 处理函数，接收索引和元素
+// 并行配置，参考 WithWorkers、WithMinChunkSize
+// 返回值:
+// 错误信息
+func ForEach[T any](
+	ctx context.Context,
+	src []T,
+	fn func(idx int, src T) error,
+	opts ...option.Option[Config],
+) error {
+	return run(ctx, newConfig(opts...).chunks(len(src)), func(_ int, start, end int, stopped func() bool) error {
+		for i := start; i < end; i++ {
+			if i%checkInterval == 0 && stopped() {
+				return nil
+			}
+			if err := fn(i, src[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
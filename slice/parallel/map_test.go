@@ -0,0 +1,261 @@
+/**
+ * Description：
+ * FileName：map_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 12:36:41
+ * Remark：
+ */
+
+package parallel
+
+import (
+	"context"
+	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/carefuly/careful-echo/slice"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func genInts(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i
+	}
+	return res
+}
+
+func TestMap(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		opts    []option.Option[Config]
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "src nil",
+			want: []string{},
+		},
+		{
+			name: "single worker",
+			src:  []int{1, 2, 3},
+			opts: []option.Option[Config]{WithWorkers(1)},
+			want: []string{"1", "2", "3"},
+		},
+		{
+			name: "more workers than elements",
+			src:  []int{1, 2, 3},
+			opts: []option.Option[Config]{WithWorkers(16)},
+			want: []string{"1", "2", "3"},
+		},
+		{
+			name: "keep order",
+			src:  genInts(1000),
+			opts: []option.Option[Config]{WithWorkers(7)},
+			want: slice.Map(genInts(1000), func(idx int, src int) string {
+				return strconv.Itoa(src)
+			}),
+		},
+		{
+			name:    "error",
+			src:     genInts(1000),
+			opts:    []option.Option[Config]{WithWorkers(4)},
+			wantErr: errBoom,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Map(context.Background(), tc.src, func(idx int, src int) (string, error) {
+				if tc.wantErr != nil && src == 500 {
+					return "", tc.wantErr
+				}
+				return strconv.Itoa(src), nil
+			}, tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				assert.Nil(t, res)
+				return
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestMapPanic(t *testing.T) {
+	res, err := Map(context.Background(), genInts(100), func(idx int, src int) (int, error) {
+		if src == 42 {
+			panic("boom")
+		}
+		return src, nil
+	}, WithWorkers(4))
+	assert.Nil(t, res)
+	assert.Equal(t, errs.NewErrTaskPanic("boom"), err)
+}
+
+func TestMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var cnt atomic.Int64
+	_, err := Map(ctx, genInts(10000), func(idx int, src int) (int, error) {
+		if cnt.Add(1) == 10 {
+			cancel()
+		}
+		return src, nil
+	}, WithWorkers(1))
+	assert.Equal(t, context.Canceled, err)
+	// 每 checkInterval 个元素检查一次，因此会在下一个检查点停止
+	assert.Equal(t, int64(checkInterval), cnt.Load())
+
+	// 已经被取消的 ctx 不会执行任何元素
+	_, err = Map(ctx, genInts(10), func(idx int, src int) (int, error) {
+		t.Fatal("should not be called")
+		return src, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+
+	// 所有元素处理完之后才取消 ctx 不算失败
+	ctx, cancel = context.WithCancel(context.Background())
+	res, err := Map(ctx, genInts(10), func(idx int, src int) (int, error) {
+		if idx == 9 {
+			cancel()
+		}
+		return src, nil
+	}, WithWorkers(1))
+	assert.NoError(t, err)
+	assert.Equal(t, genInts(10), res)
+}
+
+func TestFilterMap(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		opts    []option.Option[Config]
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "src nil",
+			want: []string{},
+		},
+		{
+			name: "keep order",
+			src:  genInts(1000),
+			opts: []option.Option[Config]{WithWorkers(7)},
+			want: slice.FilterMap(genInts(1000), func(idx int, src int) (string, bool) {
+				return strconv.Itoa(src), src%3 == 0
+			}),
+		},
+		{
+			name:    "error",
+			src:     genInts(1000),
+			opts:    []option.Option[Config]{WithWorkers(4)},
+			wantErr: errBoom,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := FilterMap(context.Background(), tc.src, func(idx int, src int) (string, bool, error) {
+				if tc.wantErr != nil && src == 999 {
+					return "", false, tc.wantErr
+				}
+				return strconv.Itoa(src), src%3 == 0, nil
+			}, tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := ForEach(context.Background(), genInts(1001), func(idx int, src int) error {
+		sum.Add(int64(src))
+		return nil
+	}, WithWorkers(8), WithMinChunkSize(10))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500500), sum.Load())
+
+	err = ForEach(context.Background(), genInts(100), func(idx int, src int) error {
+		if idx == 10 {
+			return errBoom
+		}
+		return nil
+	})
+	assert.Equal(t, errBoom, err)
+}
+
+func TestChunks(t *testing.T) {
+	testCases := []struct {
+		name string
+		n    int
+		opts []option.Option[Config]
+		want [][2]int
+	}{
+		{
+			name: "empty",
+			n:    0,
+			opts: []option.Option[Config]{WithWorkers(4)},
+		},
+		{
+			name: "even",
+			n:    8,
+			opts: []option.Option[Config]{WithWorkers(4)},
+			want: [][2]int{{0, 2}, {2, 4}, {4, 6}, {6, 8}},
+		},
+		{
+			name: "uneven",
+			n:    7,
+			opts: []option.Option[Config]{WithWorkers(3)},
+			want: [][2]int{{0, 3}, {3, 6}, {6, 7}},
+		},
+		{
+			name: "min chunk size",
+			n:    10,
+			opts: []option.Option[Config]{WithWorkers(8), WithMinChunkSize(4)},
+			want: [][2]int{{0, 4}, {4, 8}, {8, 10}},
+		},
+		{
+			name: "invalid options use default",
+			n:    3,
+			opts: []option.Option[Config]{WithWorkers(1), WithWorkers(0), WithMinChunkSize(-1)},
+			want: [][2]int{{0, 3}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, newConfig(tc.opts...).chunks(tc.n))
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func BenchmarkMap(b *testing.B) {
+	src := genInts(100000)
+	// 模拟一个有一定计算量的转换
+	m := func(idx int, src int) int {
+		res := src
+		for i := 0; i < 100; i++ {
+			res = res*31 + i
+		}
+		return res
+	}
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slice.Map(src, m)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = Map(context.Background(), src, func(idx int, src int) (int, error) {
+				return m(idx, src), nil
+			})
+		}
+	})
+}
@@ -0,0 +1,146 @@
+/**
+ * Description：
+ * FileName：parallel.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 11:20:47
+ * Remark：
+ */
+
+package parallel
+
+import (
+	"context"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// checkInterval 每处理多少个元素检查一次是否需要提前退出
+// 每个元素都检查会让求和这类轻量操作的开销成倍增加
+const checkInterval = 64
+
+// Config 并行执行的配置，字段只能通过 WithWorkers、WithMinChunkSize 设置
+type Config struct {
+	// workers 最多同时运行的 goroutine 数量
+	workers int
+	// minChunkSize 每个 goroutine 至少处理的元素个数
+	minChunkSize int
+}
+
+// WithWorkers 设置最多同时运行的 goroutine 数量
+// 默认值为 runtime.GOMAXPROCS(0)，n <= 0 时使用默认值
+func WithWorkers(n int) option.Option[Config] {
+	return func(c *Config) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
+}
+
+// WithMinChunkSize 设置每个 goroutine 至少处理的元素个数
+// 单个元素处理很快时（例如求和），调大该值可以避免调度开销超过并行收益
+// 默认值为 1，n <= 0 时使用默认值
+func WithMinChunkSize(n int) option.Option[Config] {
+	return func(c *Config) {
+		if n > 0 {
+			c.minChunkSize = n
+		}
+	}
+}
+
+func newConfig(opts ...option.Option[Config]) Config {
+	cfg := Config{
+		workers:      runtime.GOMAXPROCS(0),
+		minChunkSize: 1,
+	}
+	option.Apply(&cfg, opts...)
+	return cfg
+}
+
+// chunks 计算切分方案，返回每个分块的起止下标 [start, end)
+// 分块是连续的，因此按分块顺序合并即可保持原有顺序
+func (c Config) chunks(n int) [][2]int {
+	if n == 0 {
+		return nil
+	}
+	size := (n + c.workers - 1) / c.workers
+	if size < c.minChunkSize {
+		size = c.minChunkSize
+	}
+	res := make([][2]int, 0, (n+size-1)/size)
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		res = append(res, [2]int{start, end})
+	}
+	return res
+}
+
+// run 为每个分块启动一个 goroutine 并发执行 fn
+// 任意一个分块返回 error 或发生 panic 时，会取消其余分块并返回第一个 error
+// ctx 被取消导致有分块没有执行完时返回 ctx.Err()，所有分块都执行完之后 ctx 才被取消时仍然返回 nil
+// fn 需要自行在循环中每隔 checkInterval 个元素调用一次 stopped 检查是否应该提前退出
+func run(ctx context.Context, chunks [][2]int, fn func(chunk int, start, end int, stopped func() bool) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		// interrupted 是否有分块因为 ctx 被取消而提前退出或者没有被调度
+		interrupted atomic.Bool
+	)
+	setErr := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	done := ctx.Done()
+	stopped := func() bool {
+		select {
+		case <-done:
+			interrupted.Store(true)
+			return true
+		default:
+			return false
+		}
+	}
+
+	for i, c := range chunks {
+		if stopped() {
+			break
+		}
+		wg.Add(1)
+		go func(i, start, end int) {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					setErr(errs.NewErrTaskPanic(p))
+				}
+			}()
+			if err := fn(i, start, end, stopped); err != nil {
+				setErr(err)
+			}
+		}(i, c[0], c[1])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if interrupted.Load() {
+		// 没有分块出错，说明是外部 ctx 被取消，部分元素未被处理
+		return ctx.Err()
+	}
+	return nil
+}
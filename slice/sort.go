@@ -0,0 +1,154 @@
+/**
+ * Description：
+ * FileName：sort.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 10:36:19
+ * Remark：
+ */
+
+package slice
+
+import (
+	"container/heap"
+	echo "github.com/carefuly/careful-echo"
+	"slices"
+)
+
+// SortBy 创建一个按照比较函数排好序的新切片
+// 支持传入多个比较函数，前一个比较函数认为相等时才会使用后一个
+// 排序是稳定的，完全相等的元素保持原有的相对顺序
+// 即使输入为 nil，也保证返回非 nil 的空切片
+// 参数:
+// 原始切片
+// 一个或多个比较函数，按优先级从高到低排列
+// 返回值:
+// 排序后的新切片
+func SortBy[T any](src []T, compares ...compareFunc[T]) []T {
+	res := make([]T, len(src))
+	copy(res, src)
+	SortBySelf[T](res, compares...)
+	return res
+}
+
+// SortBySelf 原地按照比较函数对切片进行稳定排序
+// 多个比较函数的含义与 SortBy 相同
+// 参数:
+// 需要排序的切片
+// 一个或多个比较函数，按优先级从高到低排列
+func SortBySelf[T any](src []T, compares ...compareFunc[T]) {
+	if len(compares) == 0 {
+		return
+	}
+	slices.SortStableFunc(src, func(a, b T) int {
+		for _, compare := range compares {
+			if res := compare(a, b); res != 0 {
+				return res
+			}
+		}
+		return 0
+	})
+}
+
+// IsSorted 判断切片是否按照升序排列
+// 参数:
+// 包含数值的切片
+// 返回值:
+// 已按升序排列（允许相等）时返回 true
+func IsSorted[T echo.RealNumber](src []T) bool {
+	return IsSortedFunc[T](src, func(a, b T) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// IsSortedFunc 判断切片是否按照比较函数升序排列
+// 参数:
+// 任意类型的切片
+// 比较函数
+// 返回值:
+// 已按升序排列（允许相等）时返回 true
+func IsSortedFunc[T any](src []T, compare compareFunc[T]) bool {
+	for i := 1; i < len(src); i++ {
+		if compare(src[i-1], src[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TopK 返回按照比较函数最大的 k 个元素，结果按从大到小排列
+// 内部维护一个大小为 k 的小顶堆，时间复杂度为 O(n·log k)
+// 不会修改原切片
+// k 大于切片长度时返回全部元素，k <= 0 时返回空切片
+// 参数:
+// 任意类型的切片
+// 需要返回的元素个数
+// 比较函数
+// 返回值:
+// 最大的 k 个元素
+func TopK[T any](src []T, k int, compare compareFunc[T]) []T {
+	if k <= 0 || len(src) == 0 {
+		return make([]T, 0)
+	}
+	if k > len(src) {
+		k = len(src)
+	}
+
+	h := &topKHeap[T]{
+		data:    make([]T, 0, k),
+		compare: compare,
+	}
+	for _, v := range src {
+		if h.Len() < k {
+			heap.Push(h, v)
+			continue
+		}
+		// 只有比堆顶（当前第 k 大）更大的元素才需要入堆
+		if compare(v, h.data[0]) > 0 {
+			h.data[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+
+	// 依次弹出堆顶，从后往前填充得到降序结果
+	res := make([]T, h.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = heap.Pop(h).(T)
+	}
+	return res
+}
+
+// topKHeap 基于比较函数的小顶堆，实现 heap.Interface
+type topKHeap[T any] struct {
+	data    []T
+	compare compareFunc[T]
+}
+
+func (h *topKHeap[T]) Len() int {
+	return len(h.data)
+}
+
+func (h *topKHeap[T]) Less(i, j int) bool {
+	return h.compare(h.data[i], h.data[j]) < 0
+}
+
+func (h *topKHeap[T]) Swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+}
+
+func (h *topKHeap[T]) Push(x any) {
+	h.data = append(h.data, x.(T))
+}
+
+func (h *topKHeap[T]) Pop() any {
+	n := len(h.data)
+	x := h.data[n-1]
+	h.data = h.data[:n-1]
+	return x
+}
@@ -0,0 +1,169 @@
+/**
+ * Description：
+ * FileName：sort_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 10:58:03
+ * Remark：
+ */
+
+package slice
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type sortUser struct {
+	name string
+	age  int
+}
+
+func TestSortBy(t *testing.T) {
+	byAge := func(a, b sortUser) int {
+		return a.age - b.age
+	}
+	byName := func(a, b sortUser) int {
+		return strings.Compare(a.name, b.name)
+	}
+	testCases := []struct {
+		name     string
+		src      []sortUser
+		compares []compareFunc[sortUser]
+		want     []sortUser
+	}{
+		{
+			name:     "src nil",
+			compares: []compareFunc[sortUser]{byAge},
+			want:     []sortUser{},
+		},
+		{
+			name: "no compare keeps order",
+			src:  []sortUser{{"b", 2}, {"a", 1}},
+			want: []sortUser{{"b", 2}, {"a", 1}},
+		},
+		{
+			name:     "single key is stable",
+			src:      []sortUser{{"c", 2}, {"b", 1}, {"a", 2}},
+			compares: []compareFunc[sortUser]{byAge},
+			want:     []sortUser{{"b", 1}, {"c", 2}, {"a", 2}},
+		},
+		{
+			name:     "multi key",
+			src:      []sortUser{{"c", 2}, {"b", 1}, {"a", 2}},
+			compares: []compareFunc[sortUser]{byAge, byName},
+			want:     []sortUser{{"b", 1}, {"a", 2}, {"c", 2}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			origin := append([]sortUser(nil), tc.src...)
+			res := SortBy(tc.src, tc.compares...)
+			assert.Equal(t, tc.want, res)
+			// 不会修改原切片
+			assert.Equal(t, origin, tc.src)
+		})
+	}
+}
+
+func TestSortBySelf(t *testing.T) {
+	src := []int{3, 1, 2}
+	SortBySelf(src, func(a, b int) int {
+		return b - a
+	})
+	assert.Equal(t, []int{3, 2, 1}, src)
+}
+
+func TestIsSorted(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want bool
+	}{
+		{
+			name: "src nil",
+			want: true,
+		},
+		{
+			name: "single",
+			src:  []int{1},
+			want: true,
+		},
+		{
+			name: "sorted with equal",
+			src:  []int{1, 2, 2, 3},
+			want: true,
+		},
+		{
+			name: "not sorted",
+			src:  []int{1, 3, 2},
+			want: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsSorted(tc.src))
+		})
+	}
+}
+
+func TestIsSortedFunc(t *testing.T) {
+	desc := func(a, b int) int {
+		return b - a
+	}
+	assert.True(t, IsSortedFunc([]int{3, 2, 1}, desc))
+	assert.False(t, IsSortedFunc([]int{1, 2, 3}, desc))
+}
+
+func TestTopK(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		k    int
+		want []int
+	}{
+		{
+			name: "src nil",
+			k:    3,
+			want: []int{},
+		},
+		{
+			name: "k 0",
+			src:  []int{1, 2, 3},
+			k:    0,
+			want: []int{},
+		},
+		{
+			name: "k larger than length",
+			src:  []int{2, 3, 1},
+			k:    5,
+			want: []int{3, 2, 1},
+		},
+		{
+			name: "normal",
+			src:  []int{5, 1, 9, 3, 7, 9, 2},
+			k:    3,
+			want: []int{9, 9, 7},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			origin := append([]int(nil), tc.src...)
+			res := TopK(tc.src, tc.k, func(a, b int) int {
+				return a - b
+			})
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, origin, tc.src)
+		})
+	}
+}
+
+func ExampleTopK() {
+	res := TopK([]int{5, 1, 9, 3, 7}, 2, func(a, b int) int {
+		return a - b
+	})
+	fmt.Println(res)
+	// Output:
+	// [9 7]
+}
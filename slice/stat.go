@@ -0,0 +1,138 @@
+/**
+ * Description：
+ * FileName：stat.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 14:05:33
+ * Remark：
+ */
+
+package slice
+
+import (
+	echo "github.com/carefuly/careful-echo"
+	"math"
+)
+
+// Avg 返回切片的算术平均值
+// 参数：
+// 包含数值的切片
+// 返回值：
+// 平均值
+// 切片是否非空
+func Avg[T echo.RealNumber](values []T) (float64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	var agg Aggregator[T]
+	agg.Add(values...)
+	return agg.Avg()
+}
+
+// Variance 返回切片的总体方差
+// 使用 Welford 算法计算，避免先求平方和再相减带来的精度损失
+// 参数：
+// 包含数值的切片
+// 返回值：
+// 总体方差
+// 切片是否非空
+func Variance[T echo.RealNumber](values []T) (float64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	var agg Aggregator[T]
+	agg.Add(values...)
+	return agg.Variance()
+}
+
+// StdDev 返回切片的总体标准差
+// 参数：
+// 包含数值的切片
+// 返回值：
+// 总体标准差
+// 切片是否非空
+func StdDev[T echo.RealNumber](values []T) (float64, bool) {
+	variance, ok := Variance[T](values)
+	return math.Sqrt(variance), ok
+}
+
+// Mode 返回切片中出现次数最多的元素
+// 存在多个出现次数相同的元素时，返回最先出现的那个
+// 参数：
+// 切片
+// 返回值：
+// 出现次数最多的元素
+// 切片是否非空
+func Mode[T comparable](values []T) (T, bool) {
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	counts := make(map[T]int, len(values))
+	maxCnt := 0
+	for _, v := range values {
+		counts[v]++
+		if counts[v] > maxCnt {
+			maxCnt = counts[v]
+		}
+	}
+	// 按原始顺序查找，保证平局时返回最先出现的元素
+	res := values[0]
+	for _, v := range values {
+		if counts[v] == maxCnt {
+			res = v
+			break
+		}
+	}
+	return res, true
+}
+
+// Bucket 直方图中的一个桶，统计落在 [Low, High) 区间内的元素个数
+// 最后一个桶的区间为 [Low, High]
+type Bucket struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// Histogram 将切片元素按等宽区间分桶计数
+// 区间范围为切片的 [最小值, 最大值]，被均分为 buckets 个桶
+// 所有元素相等时，全部元素计入第一个桶
+// 注意:
+// - 即使输入为 nil，也保证返回非 nil 的空切片
+// - buckets <= 0 时会 panic
+// 参数：
+// 包含数值的切片
+// 桶的个数
+// 返回值：
+// 按区间从小到大排列的桶
+func Histogram[T echo.RealNumber](values []T, buckets int) []Bucket {
+	if buckets <= 0 {
+		panic("桶的个数必须大于 0")
+	}
+	if len(values) == 0 {
+		return make([]Bucket, 0)
+	}
+
+	low, high := float64(Min[T](values)), float64(Max[T](values))
+	width := (high - low) / float64(buckets)
+	res := make([]Bucket, buckets)
+	for i := range res {
+		res[i].Low = low + width*float64(i)
+		res[i].High = low + width*float64(i+1)
+	}
+	// 避免浮点误差导致最后一个桶的上界小于最大值
+	res[buckets-1].High = high
+
+	for _, v := range values {
+		idx := 0
+		if width > 0 {
+			idx = int((float64(v) - low) / width)
+		}
+		// 最大值落在最后一个桶的右边界上
+		if idx >= buckets {
+			idx = buckets - 1
+		}
+		res[idx].Count++
+	}
+	return res
+}
@@ -0,0 +1,143 @@
+/**
+ * Description：
+ * FileName：stat_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 14:26:12
+ * Remark：
+ */
+
+package slice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAvgVarianceStdDev(t *testing.T) {
+	testCases := []struct {
+		name         string
+		input        []Integer
+		wantAvg      float64
+		wantVariance float64
+		wantStdDev   float64
+		wantOk       bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:    "value",
+			input:   []Integer{3},
+			wantAvg: 3,
+			wantOk:  true,
+		},
+		{
+			name:         "values",
+			input:        []Integer{2, 4, 4, 4, 5, 5, 7, 9},
+			wantAvg:      5,
+			wantVariance: 4,
+			wantStdDev:   2,
+			wantOk:       true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			avg, ok := Avg(tc.input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.InDelta(t, tc.wantAvg, avg, 1e-9)
+
+			variance, ok := Variance(tc.input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.InDelta(t, tc.wantVariance, variance, 1e-9)
+
+			stdDev, ok := StdDev(tc.input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.InDelta(t, tc.wantStdDev, stdDev, 1e-9)
+		})
+	}
+}
+
+func TestAvgNoOverflow(t *testing.T) {
+	avg, ok := Avg([]int8{127, 127, 127})
+	assert.True(t, ok)
+	assert.Equal(t, float64(127), avg)
+}
+
+func TestMode(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []string
+		want   string
+		wantOk bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "unique",
+			input:  []string{"a", "b", "b", "c"},
+			want:   "b",
+			wantOk: true,
+		},
+		{
+			name:   "tie returns first occurrence",
+			input:  []string{"a", "b", "b", "a"},
+			want:   "a",
+			wantOk: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := Mode(tc.input)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []float64
+		buckets int
+		want    []Bucket
+	}{
+		{
+			name:    "nil",
+			buckets: 2,
+			want:    []Bucket{},
+		},
+		{
+			name:    "same values",
+			input:   []float64{1, 1, 1},
+			buckets: 2,
+			want: []Bucket{
+				{Low: 1, High: 1, Count: 3},
+				{Low: 1, High: 1, Count: 0},
+			},
+		},
+		{
+			name:    "values",
+			input:   []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			buckets: 4,
+			want: []Bucket{
+				{Low: 0, High: 2.5, Count: 3},
+				{Low: 2.5, High: 5, Count: 2},
+				{Low: 5, High: 7.5, Count: 3},
+				{Low: 7.5, High: 10, Count: 3},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Histogram(tc.input, tc.buckets)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestHistogramPanic(t *testing.T) {
+	assert.Panics(t, func() {
+		Histogram([]int{1}, 0)
+	})
+}
@@ -10,7 +10,7 @@ package slice
 
 // SymmetricDiffSet 计算两个切片的对称差集（已去重）
 // 使用内置 comparable 约束，适用于可直接比较的元素类型
-// 返回结果顺序不固定
+// 返回顺序不确定
 // 参数:
 // 第一个切片
 // 第二个切片
@@ -37,6 +37,8 @@ func SymmetricDiffSet[T comparable](src, dst []T) []T {
 // SymmetricDiffSetFunc 计算两个切片的对称差集（已去重）
 // 使用自定义相等函数，适用于无法直接比较的元素类型
 // 你应该优先使用 SymmetricDiffSet（当元素类型满足 comparable 时）
+// 返回顺序为先 src 后 dst 中第一次出现的顺序
+// 时间复杂度为 O(n·m)，能够提取 comparable 键时应优先使用 SymmetricDiffSetBy
 // 参数:
 // 第一个切片
 // 第二个切片
@@ -66,3 +68,38 @@ func SymmetricDiffSetFunc[T any](src, dst []T, equal equalFunc[T]) []T {
 
 	return deduplicateFunc[T](res, equal)
 }
+
+// SymmetricDiffSetBy 使用键函数计算两个切片的对称差集（已去重）
+// 键相同即视为相等，时间复杂度为 O(n+m)
+// 返回顺序与 SymmetricDiffSetFunc 相同，为先 src 后 dst 中第一次出现的顺序
+// 参数:
+// 第一个切片
+// 第二个切片
+// 从元素中提取键的函数
+// 返回值:
+// 对称差集切片，包含所有只存在于一个切片中的元素
+func SymmetricDiffSetBy[T any, K comparable](src, dst []T, key func(src T) K) []T {
+	srcMap, dstMap := toKeyMap[T, K](src, key), toKeyMap[T, K](dst, key)
+	seen := make(map[K]struct{}, len(src)+len(dst))
+	res := make([]T, 0, len(src)+len(dst))
+
+	collect := func(data []T, other map[K]struct{}) {
+		for _, v := range data {
+			k := key(v)
+			if _, exist := other[k]; exist {
+				continue
+			}
+			if _, exist := seen[k]; exist {
+				continue
+			}
+			seen[k] = struct{}{}
+			res = append(res, v)
+		}
+	}
+	// 找出在src不在dst的元素
+	collect(src, dstMap)
+	// 找出在dst不在src的元素
+	collect(dst, srcMap)
+
+	return res
+}
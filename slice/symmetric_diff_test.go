@@ -178,3 +178,33 @@ func TestSymmetricDiffSetFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestSymmetricDiffSetBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []setUser
+		dst  []setUser
+		want []setUser
+	}{
+		{
+			name: "both nil",
+			want: []setUser{},
+		},
+		{
+			name: "src first then dst",
+			src:  []setUser{{2, "src-2"}, {1, "src-1"}, {2, "src-2-dup"}},
+			dst:  []setUser{{5, "dst-5"}, {1, "dst-1"}, {4, "dst-4"}, {5, "dst-5-dup"}},
+			want: []setUser{{2, "src-2"}, {5, "dst-5"}, {4, "dst-4"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := SymmetricDiffSetBy(tc.src, tc.dst, setUserID)
+			assert.Equal(t, tc.want, res)
+			// 与 SymmetricDiffSetFunc 的顺序保证一致
+			if len(tc.want) > 0 {
+				assert.Equal(t, tc.want, SymmetricDiffSetFunc(tc.src, tc.dst, setUserEqual))
+			}
+		})
+	}
+}
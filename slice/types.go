@@ -12,3 +12,7 @@ package slice
 type equalFunc[T any] func(src, dst T) bool
 
 type matchFunc[T any] func(src T) bool
+
+// compareFunc 比较两个元素的大小
+// a < b 时返回负数，a == b 时返回 0，a > b 时返回正数
+type compareFunc[T any] func(a, b T) int
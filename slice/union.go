@@ -10,7 +10,7 @@ package slice
 
 // UnionSet 计算两个切片的并集（已去重）
 // 使用内置 comparable 约束，适用于可直接比较的元素类型
-// 返回结果顺序不固定
+// 返回顺序不确定
 // 参数:
 // 第一个切片
 // 第二个切片
@@ -33,6 +33,8 @@ func UnionSet[T comparable](src, dst []T) []T {
 // UnionSetFunc 计算两个切片的并集（已去重）
 // 使用自定义相等函数，适用于无法直接比较的元素类型
 // 你应该优先使用 UnionSet（当元素类型满足 comparable 时）
+// 返回顺序为先 dst 后 src 中第一次出现的顺序，重复元素保留第一次出现的那个
+// 时间复杂度为 O((n+m)²)，能够提取 comparable 键时应优先使用 UnionSetBy
 // 参数:
 // 第一个切片
 // 第二个切片
@@ -47,3 +49,28 @@ func UnionSetFunc[T any](src, dst []T, equal equalFunc[T]) []T {
 
 	return deduplicateFunc[T](ret, equal)
 }
+
+// UnionSetBy 使用键函数计算两个切片的并集（已去重）
+// 键相同即视为相等，时间复杂度为 O(n+m)
+// 返回顺序与 UnionSetFunc 相同，为先 dst 后 src 中第一次出现的顺序，重复元素保留第一次出现的那个
+// 参数:
+// 第一个切片
+// 第二个切片
+// 从元素中提取键的函数
+// 返回值:
+// 并集切片，包含所有出现在任一输入切片中的唯一元素
+func UnionSetBy[T any, K comparable](src, dst []T, key func(src T) K) []T {
+	seen := make(map[K]struct{}, len(src)+len(dst))
+	var ret = make([]T, 0, len(src)+len(dst))
+	for _, data := range [2][]T{dst, src} {
+		for _, v := range data {
+			k := key(v)
+			if _, exist := seen[k]; exist {
+				continue
+			}
+			seen[k] = struct{}{}
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
@@ -96,3 +96,33 @@ func TestUnionSetFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestUnionSetBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []setUser
+		dst  []setUser
+		want []setUser
+	}{
+		{
+			name: "both nil",
+			want: []setUser{},
+		},
+		{
+			name: "dst first then src",
+			src:  []setUser{{1, "src-1"}, {2, "src-2"}, {2, "src-2-dup"}},
+			dst:  []setUser{{3, "dst-3"}, {1, "dst-1"}},
+			want: []setUser{{3, "dst-3"}, {1, "dst-1"}, {2, "src-2"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := UnionSetBy(tc.src, tc.dst, setUserID)
+			assert.Equal(t, tc.want, res)
+			// 与 UnionSetFunc 的顺序保证一致
+			if len(tc.want) > 0 {
+				assert.Equal(t, tc.want, UnionSetFunc(tc.src, tc.dst, setUserEqual))
+			}
+		})
+	}
+}
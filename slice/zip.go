@@ -0,0 +1,80 @@
+/**
+ * Description：
+ * FileName：zip.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 09:48:52
+ * Remark：
+ */
+
+package slice
+
+import "github.com/carefuly/careful-echo/tuple/pair"
+
+// Flatten 将二维切片展开为一维切片
+// 元素顺序与原二维切片的遍历顺序一致
+// 即使输入为 nil，也保证返回非 nil 的空切片
+// 参数:
+// 二维切片
+// 返回值:
+// 展开后的新切片
+func Flatten[T any](src [][]T) []T {
+	total := 0
+	for _, s := range src {
+		total += len(s)
+	}
+	res := make([]T, 0, total)
+	for _, s := range src {
+		res = append(res, s...)
+	}
+	return res
+}
+
+// Zip 将两个切片按下标一一组合为 pair.Pair
+// 结果长度以较短的切片为准，多余的元素会被丢弃
+// 即使输入为 nil，也保证返回非 nil 的空切片
+// 参数:
+// 作为 Key 的切片
+// 作为 Value 的切片
+// 返回值:
+// 组合后的 Pair 切片
+func Zip[K any, V any](keys []K, values []V) []pair.Pair[K, V] {
+	return pair.Zip(keys, values)
+}
+
+// Unzip 将 pair.Pair 切片拆分为 Key 切片和 Value 切片，是 Zip 的逆操作
+// 即使输入为 nil，也保证两个返回值都是非 nil 的空切片
+// 参数:
+// Pair 切片
+// 返回值:
+// 所有 Key 组成的切片
+// 所有 Value 组成的切片
+func Unzip[K any, V any](pairs []pair.Pair[K, V]) ([]K, []V) {
+	return pair.Unzip(pairs)
+}
+
+// Interleave 按轮询的方式交错合并多个切片
+// 依次从每个切片中取出一个元素，较短的切片耗尽后会被跳过
+// 例如 [1 2 3], [4 5], [6] 合并后为 [1 4 6 2 5 3]
+// 即使输入为 nil，也保证返回非 nil 的空切片
+// 参数:
+// 需要合并的切片
+// 返回值:
+// 交错合并后的新切片
+func Interleave[T any](srcs ...[]T) []T {
+	total, maxLen := 0, 0
+	for _, s := range srcs {
+		total += len(s)
+		if len(s) > maxLen {
+			maxLen = len(s)
+		}
+	}
+	res := make([]T, 0, total)
+	for i := 0; i < maxLen; i++ {
+		for _, s := range srcs {
+			if i < len(s) {
+				res = append(res, s[i])
+			}
+		}
+	}
+	return res
+}
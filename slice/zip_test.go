@@ -0,0 +1,146 @@
+/**
+ * Description：
+ * FileName：zip_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 10:02:45
+ * Remark：
+ */
+
+package slice
+
+import (
+	"github.com/carefuly/careful-echo/tuple/pair"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  [][]int
+		want []int
+	}{
+		{
+			name: "src nil",
+			want: []int{},
+		},
+		{
+			name: "contains nil",
+			src:  [][]int{{1, 2}, nil, {3}},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "normal",
+			src:  [][]int{{1}, {2, 3}, {4, 5, 6}},
+			want: []int{1, 2, 3, 4, 5, 6},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Flatten(tc.src)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	testCases := []struct {
+		name   string
+		keys   []string
+		values []int
+		want   []pair.Pair[string, int]
+	}{
+		{
+			name: "both nil",
+			want: []pair.Pair[string, int]{},
+		},
+		{
+			name:   "same length",
+			keys:   []string{"a", "b"},
+			values: []int{1, 2},
+			want: []pair.Pair[string, int]{
+				pair.NewPair("a", 1),
+				pair.NewPair("b", 2),
+			},
+		},
+		{
+			name:   "values shorter",
+			keys:   []string{"a", "b", "c"},
+			values: []int{1},
+			want: []pair.Pair[string, int]{
+				pair.NewPair("a", 1),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Zip(tc.keys, tc.values)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pairs      []pair.Pair[string, int]
+		wantKeys   []string
+		wantValues []int
+	}{
+		{
+			name:       "pairs nil",
+			wantKeys:   []string{},
+			wantValues: []int{},
+		},
+		{
+			name: "normal",
+			pairs: []pair.Pair[string, int]{
+				pair.NewPair("a", 1),
+				pair.NewPair("b", 2),
+			},
+			wantKeys:   []string{"a", "b"},
+			wantValues: []int{1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys, values := Unzip(tc.pairs)
+			assert.Equal(t, tc.wantKeys, keys)
+			assert.Equal(t, tc.wantValues, values)
+		})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	testCases := []struct {
+		name string
+		srcs [][]int
+		want []int
+	}{
+		{
+			name: "no input",
+			want: []int{},
+		},
+		{
+			name: "single",
+			srcs: [][]int{{1, 2, 3}},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "different length",
+			srcs: [][]int{{1, 2, 3}, {4, 5}, {6}},
+			want: []int{1, 4, 6, 2, 5, 3},
+		},
+		{
+			name: "contains nil",
+			srcs: [][]int{nil, {1, 2}, {3}},
+			want: []int{1, 3, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Interleave(tc.srcs...)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/**
+ * Description：
+ * FileName：json.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 10:05:26
+ * Remark：
+ */
+
+package sqlx
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+)
+
+// JsonColumn 代表以 JSON 格式存储的列，实现了 sql.Scanner 和 driver.Valuer
+// Valid 为 false 时代表 NULL
+type JsonColumn[T any] struct {
+	Val   T
+	Valid bool
+}
+
+// Value 实现 driver.Valuer，Valid 为 false 时写入 NULL，否则写入 JSON 编码后的 []byte
+func (j JsonColumn[T]) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return json.Marshal(j.Val)
+}
+
+// Scan 实现 sql.Scanner，src 支持 []byte、string 以及 nil
+// nil 扫描为 Valid = false 且 Val 为零值，其它类型返回 errs.NewErrInvalidType
+// JSON 中的 null 是合法的值，扫描为 Valid = true 且 Val 为零值
+func (j *JsonColumn[T]) Scan(src any) error {
+	var bs []byte
+	switch val := src.(type) {
+	case nil:
+		var zero T
+		j.Val, j.Valid = zero, false
+		return nil
+	case []byte:
+		bs = val
+	case string:
+		bs = []byte(val)
+	default:
+		return errs.NewErrInvalidType("[]byte", src)
+	}
+	// 解码到新的变量中，避免 map、切片等残留上一次扫描的数据
+	var v T
+	if err := json.Unmarshal(bs, &v); err != nil {
+		return err
+	}
+	j.Val, j.Valid = v, true
+	return nil
+}
@@ -0,0 +1,256 @@
+/**
+ * Description：
+ * FileName：json_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/21 10:21:48
+ * Remark：
+ */
+
+package sqlx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"sync"
+	"testing"
+)
+
+type user struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+func TestJsonColumnValue(t *testing.T) {
+	testCases := []struct {
+		name    string
+		valuer  driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:   "struct",
+			valuer: JsonColumn[user]{Val: user{Name: "Tom", Tags: []string{"a"}}, Valid: true},
+			want:   []byte(`{"name":"Tom","tags":["a"]}`),
+		},
+		{
+			name:   "invalid",
+			valuer: JsonColumn[user]{Val: user{Name: "Tom"}},
+			want:   nil,
+		},
+		{
+			name:   "nil slice",
+			valuer: JsonColumn[[]int]{Valid: true},
+			want:   []byte(`null`),
+		},
+		{
+			name:   "pointer",
+			valuer: &JsonColumn[map[string]int]{Val: map[string]int{"a": 1}, Valid: true},
+			want:   []byte(`{"a":1}`),
+		},
+		{
+			name:    "marshal error",
+			valuer:  JsonColumn[chan int]{Val: make(chan int), Valid: true},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := tc.valuer.Value()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, val)
+		})
+	}
+}
+
+func TestJsonColumnScan(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     any
+		want    JsonColumn[user]
+		wantErr error
+	}{
+		{
+			name: "bytes",
+			src:  []byte(`{"name":"Tom","tags":["a"]}`),
+			want: JsonColumn[user]{Val: user{Name: "Tom", Tags: []string{"a"}}, Valid: true},
+		},
+		{
+			name: "string",
+			src:  `{"name":"Tom"}`,
+			want: JsonColumn[user]{Val: user{Name: "Tom"}, Valid: true},
+		},
+		{
+			name: "nil",
+			src:  nil,
+			want: JsonColumn[user]{},
+		},
+		{
+			name: "json null",
+			src:  "null",
+			want: JsonColumn[user]{Valid: true},
+		},
+		{
+			name:    "invalid type",
+			src:     int64(1),
+			wantErr: errs.NewErrInvalidType("[]byte", int64(1)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// 扫描前的值不会残留到结果中
+			j := JsonColumn[user]{Val: user{Name: "old", Tags: []string{"old"}}, Valid: true}
+			err := j.Scan(tc.src)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, j)
+		})
+	}
+
+	var j JsonColumn[user]
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, j.Scan(`{"name":`), &syntaxErr)
+	assert.False(t, j.Valid)
+}
+
+func TestJsonColumnDB(t *testing.T) {
+	db, err := sql.Open("echo-fake", t.Name())
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("INSERT", JsonColumn[user]{Val: user{Name: "Tom", Tags: []string{"a", "b"}}, Valid: true})
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT", JsonColumn[user]{})
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT", `{"name":"Jerry"}`)
+	require.NoError(t, err)
+
+	rows, err := db.Query("SELECT")
+	require.NoError(t, err)
+	defer rows.Close()
+	var got []JsonColumn[user]
+	for rows.Next() {
+		var j JsonColumn[user]
+		require.NoError(t, rows.Scan(&j))
+		got = append(got, j)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []JsonColumn[user]{
+		{Val: user{Name: "Tom", Tags: []string{"a", "b"}}, Valid: true},
+		{},
+		{Val: user{Name: "Jerry"}, Valid: true},
+	}, got)
+
+	// 驱动返回的类型无法解析
+	_, err = db.Exec("INSERT", int64(1))
+	require.NoError(t, err)
+	var j JsonColumn[user]
+	err = db.QueryRow("SELECT LAST").Scan(&j)
+	assert.ErrorContains(t, err, errs.NewErrInvalidType("[]byte", int64(1)).Error())
+}
+
+func init() {
+	sql.Register("echo-fake", &fakeDriver{tables: map[string]*fakeTable{}})
+}
+
+// fakeDriver 内存中的假驱动，每个 dsn 对应一张只有一列的表
+// INSERT 追加一行，SELECT 返回所有行，SELECT LAST 返回最后一行
+type fakeDriver struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+}
+
+type fakeTable struct {
+	mu   sync.Mutex
+	rows []driver.Value
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tb, ok := d.tables[dsn]
+	if !ok {
+		tb = &fakeTable{}
+		d.tables[dsn] = tb
+	}
+	return &fakeConn{table: tb}, nil
+}
+
+type fakeConn struct {
+	table *fakeTable
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{table: c.table, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fake: 不支持事务")
+}
+
+type fakeStmt struct {
+	table *fakeTable
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.table.mu.Lock()
+	defer s.table.mu.Unlock()
+	s.table.rows = append(s.table.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.table.mu.Lock()
+	defer s.table.mu.Unlock()
+	rows := append([]driver.Value(nil), s.table.rows...)
+	if s.query == "SELECT LAST" {
+		rows = rows[len(rows)-1:]
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows []driver.Value
+	pos  int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"v"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	dest[0] = r.rows[r.pos]
+	r.pos++
+	return nil
+}
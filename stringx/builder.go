@@ -0,0 +1,121 @@
+/**
+ * Description：
+ * FileName：builder.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 23:52:40
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/carefuly/careful-echo/bytex"
+	"unicode/utf8"
+)
+
+// Builder 基于 bytex.BufferPool 的字符串构建器，零值可以直接使用
+// 扩容时从池中获取新的切片，并将不再使用的旧切片归还到池中
+// String 通过零拷贝的方式返回结果，此后底层切片被返回的字符串引用，不会再被归还
+// Builder 不能被复制，也不是并发安全的
+type Builder struct {
+	buf *[]byte
+	// shared 为 true 表示 buf 已经被 String 返回的字符串引用
+	shared bool
+}
+
+// NewBuilder 创建一个初始容量不小于 size 的 Builder
+func NewBuilder(size int) *Builder {
+	b := &Builder{}
+	b.Grow(size)
+	return b
+}
+
+// Len 返回已写入的字节数
+func (b *Builder) Len() int {
+	if b.buf == nil {
+		return 0
+	}
+	return len(*b.buf)
+}
+
+// Cap 返回底层切片的容量
+func (b *Builder) Cap() int {
+	if b.buf == nil {
+		return 0
+	}
+	return cap(*b.buf)
+}
+
+// Grow 保证至少还能写入 n 个字节而不需要扩容
+func (b *Builder) Grow(n int) {
+	if n < 0 {
+		panic("echo: Grow 的参数不能为负数")
+	}
+	if b.Cap()-b.Len() >= n {
+		return
+	}
+	size := b.Len() + n
+	if c := 2 * b.Cap(); c > size {
+		size = c
+	}
+	nb := bytex.Get(size)
+	if b.buf != nil {
+		*nb = append(*nb, *b.buf...)
+		b.release()
+	}
+	b.buf = nb
+	b.shared = false
+}
+
+// Write 实现 io.Writer，总是返回 len(p), nil
+func (b *Builder) Write(p []byte) (int, error) {
+	b.Grow(len(p))
+	*b.buf = append(*b.buf, p...)
+	return len(p), nil
+}
+
+// WriteString 实现 io.StringWriter，总是返回 len(s), nil
+func (b *Builder) WriteString(s string) (int, error) {
+	b.Grow(len(s))
+	*b.buf = append(*b.buf, s...)
+	return len(s), nil
+}
+
+// WriteByte 实现 io.ByteWriter，总是返回 nil
+func (b *Builder) WriteByte(c byte) error {
+	b.Grow(1)
+	*b.buf = append(*b.buf, c)
+	return nil
+}
+
+// WriteRune 写入 r 的 UTF-8 编码，总是返回 nil
+func (b *Builder) WriteRune(r rune) (int, error) {
+	b.Grow(utf8.UTFMax)
+	n := len(*b.buf)
+	*b.buf = utf8.AppendRune(*b.buf, r)
+	return len(*b.buf) - n, nil
+}
+
+// String 零拷贝地返回已写入的内容，可以多次调用
+// 之后继续写入只会追加到已返回内容的后面，不会修改已返回的字符串
+func (b *Builder) String() string {
+	if b.buf == nil {
+		return ""
+	}
+	b.shared = true
+	return UnsafeToString(*b.buf)
+}
+
+// Reset 清空 Builder
+// 如果底层切片没有被 String 返回的字符串引用，会将其归还到池中
+func (b *Builder) Reset() {
+	b.release()
+	b.buf = nil
+	b.shared = false
+}
+
+func (b *Builder) release() {
+	if b.buf != nil && !b.shared {
+		bytex.Put(b.buf)
+	}
+}
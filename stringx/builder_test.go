@@ -0,0 +1,145 @@
+/**
+ * Description：
+ * FileName：builder_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 00:19:33
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	testCases := []struct {
+		name  string
+		write func(b *Builder)
+		want  string
+	}{
+		{
+			name:  "empty",
+			write: func(b *Builder) {},
+			want:  "",
+		},
+		{
+			name: "mixed",
+			write: func(b *Builder) {
+				_, _ = b.WriteString("hello")
+				_ = b.WriteByte(' ')
+				_, _ = b.Write([]byte("世界"))
+				_, _ = b.WriteRune('😀')
+			},
+			want: "hello 世界😀",
+		},
+		{
+			name: "grow",
+			write: func(b *Builder) {
+				for i := 0; i < 1000; i++ {
+					_, _ = b.WriteString("abc")
+				}
+			},
+			want: strings.Repeat("abc", 1000),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var b Builder
+			tc.write(&b)
+			assert.Equal(t, len(tc.want), b.Len())
+			assert.Equal(t, tc.want, b.String())
+			assert.Equal(t, tc.want, b.String())
+			b.Reset()
+			assert.Equal(t, 0, b.Len())
+			assert.Equal(t, "", b.String())
+		})
+	}
+}
+
+func TestBuilderStringStable(t *testing.T) {
+	b := NewBuilder(8)
+	assert.GreaterOrEqual(t, b.Cap(), 8)
+	_, _ = b.WriteString("hello")
+	s1 := b.String()
+
+	// 继续写入（包括扩容）不会影响已经返回的字符串
+	_, _ = b.WriteString(" world")
+	_, _ = b.WriteString(strings.Repeat("x", 4096))
+	s2 := b.String()
+	assert.Equal(t, "hello", s1)
+	assert.Equal(t, "hello world"+strings.Repeat("x", 4096), s2)
+
+	// Reset 后底层切片不会被归还复用，已返回的字符串保持不变
+	b.Reset()
+	for i := 0; i < 10; i++ {
+		nb := NewBuilder(8)
+		_, _ = nb.WriteString("HELLO")
+		nb.Reset()
+	}
+	assert.Equal(t, "hello", s1)
+	assert.Equal(t, "hello world"+strings.Repeat("x", 4096), s2)
+}
+
+func TestBuilderGrowNegative(t *testing.T) {
+	assert.Panics(t, func() {
+		var b Builder
+		b.Grow(-1)
+	})
+}
+
+var benchParts = []string{"hello", " ", "world", ", ", "你好", "世界", "!"}
+
+func BenchmarkBuilder(b *testing.B) {
+	b.Run("stringx.Builder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var sb Builder
+			for j := 0; j < 32; j++ {
+				for _, p := range benchParts {
+					_, _ = sb.WriteString(p)
+				}
+			}
+			_ = sb.String()
+		}
+	})
+	b.Run("stringx.Builder reset", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var sb Builder
+			for j := 0; j < 32; j++ {
+				for _, p := range benchParts {
+					_, _ = sb.WriteString(p)
+				}
+			}
+			sb.Reset()
+		}
+	})
+	b.Run("strings.Builder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var sb strings.Builder
+			for j := 0; j < 32; j++ {
+				for _, p := range benchParts {
+					sb.WriteString(p)
+				}
+			}
+			_ = sb.String()
+		}
+	})
+	b.Run("bytes.Buffer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var buf bytes.Buffer
+			for j := 0; j < 32; j++ {
+				for _, p := range benchParts {
+					buf.WriteString(p)
+				}
+			}
+			_ = buf.String()
+		}
+	})
+}
@@ -0,0 +1,108 @@
+/**
+ * Description：
+ * FileName：case.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 22:20:14
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// CamelCase 转换为小驼峰，例如 "HTTP server" => "httpServer"
+// 缩写词会被视为一个单词，只保留首字母大写
+func CamelCase(s string) string {
+	words := splitWords(s)
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i, w := range words {
+		if i == 0 {
+			sb.WriteString(strings.ToLower(w))
+			continue
+		}
+		writeTitle(&sb, w)
+	}
+	return sb.String()
+}
+
+// PascalCase 转换为大驼峰，例如 "user_id" => "UserId"
+// 缩写词会被视为一个单词，只保留首字母大写
+func PascalCase(s string) string {
+	words := splitWords(s)
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, w := range words {
+		writeTitle(&sb, w)
+	}
+	return sb.String()
+}
+
+// SnakeCase 转换为下划线风格，例如 "HTTPServerID" => "http_server_id"
+func SnakeCase(s string) string {
+	return joinLower(splitWords(s), '_')
+}
+
+// KebabCase 转换为中划线风格，例如 "HTTPServerID" => "http-server-id"
+func KebabCase(s string) string {
+	return joinLower(splitWords(s), '-')
+}
+
+func joinLower(words []string, sep byte) string {
+	var sb strings.Builder
+	for i, w := range words {
+		if i > 0 {
+			sb.WriteByte(sep)
+		}
+		sb.WriteString(strings.ToLower(w))
+	}
+	return sb.String()
+}
+
+func writeTitle(sb *strings.Builder, w string) {
+	for i, r := range w {
+		if i == 0 {
+			sb.WriteRune(unicode.ToUpper(r))
+			continue
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+}
+
+// splitWords 按照以下规则拆分单词：
+// 非字母数字的字符作为分隔符；小写或数字后紧跟大写时拆分；
+// 连续大写后紧跟小写时，最后一个大写属于下一个单词（"HTTPServer" => "HTTP"、"Server"）
+func splitWords(s string) []string {
+	rs := []rune(s)
+	res := make([]string, 0, 4)
+	start := -1
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				res = append(res, string(rs[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		if !unicode.IsUpper(r) {
+			continue
+		}
+		prev := rs[i-1]
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+			(unicode.IsUpper(prev) && i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+			res = append(res, string(rs[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		res = append(res, string(rs[start:]))
+	}
+	return res
+}
@@ -0,0 +1,101 @@
+/**
+ * Description：
+ * FileName：case_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 22:52:08
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCaseConversion(t *testing.T) {
+	testCases := []struct {
+		name       string
+		val        string
+		wantCamel  string
+		wantPascal string
+		wantSnake  string
+		wantKebab  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:       "snake",
+			val:        "user_name",
+			wantCamel:  "userName",
+			wantPascal: "UserName",
+			wantSnake:  "user_name",
+			wantKebab:  "user-name",
+		},
+		{
+			name:       "camel",
+			val:        "userName",
+			wantCamel:  "userName",
+			wantPascal: "UserName",
+			wantSnake:  "user_name",
+			wantKebab:  "user-name",
+		},
+		{
+			name:       "leading acronym",
+			val:        "HTTPServer",
+			wantCamel:  "httpServer",
+			wantPascal: "HttpServer",
+			wantSnake:  "http_server",
+			wantKebab:  "http-server",
+		},
+		{
+			name:       "trailing acronym",
+			val:        "userID",
+			wantCamel:  "userId",
+			wantPascal: "UserId",
+			wantSnake:  "user_id",
+			wantKebab:  "user-id",
+		},
+		{
+			name:       "middle acronym",
+			val:        "parseHTMLBody",
+			wantCamel:  "parseHtmlBody",
+			wantPascal: "ParseHtmlBody",
+			wantSnake:  "parse_html_body",
+			wantKebab:  "parse-html-body",
+		},
+		{
+			name:       "digits",
+			val:        "oauth2Token v10",
+			wantCamel:  "oauth2TokenV10",
+			wantPascal: "Oauth2TokenV10",
+			wantSnake:  "oauth2_token_v10",
+			wantKebab:  "oauth2-token-v10",
+		},
+		{
+			name:       "mixed separators",
+			val:        "  --Hello  World__foo.bar ",
+			wantCamel:  "helloWorldFooBar",
+			wantPascal: "HelloWorldFooBar",
+			wantSnake:  "hello_world_foo_bar",
+			wantKebab:  "hello-world-foo-bar",
+		},
+		{
+			name:       "unicode",
+			val:        "Ünïcode_ÉTÉ 你好",
+			wantCamel:  "ünïcodeÉté你好",
+			wantPascal: "ÜnïcodeÉté你好",
+			wantSnake:  "ünïcode_été_你好",
+			wantKebab:  "ünïcode-été-你好",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantCamel, CamelCase(tc.val))
+			assert.Equal(t, tc.wantPascal, PascalCase(tc.val))
+			assert.Equal(t, tc.wantSnake, SnakeCase(tc.val))
+			assert.Equal(t, tc.wantKebab, KebabCase(tc.val))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+//go:build !echo_debug
+
+/**
+ * Description：
+ * FileName：debug.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 00:41:16
+ * Remark：
+ */
+
+package stringx
+
+// track 非调试模式下什么都不做，会被内联消除
+func track(*byte, int) {}
+
+// VerifyUnsafe 检查零拷贝转换的结果是否被修改
+// 只有使用 echo_debug 构建标签时才会真正检查，否则总是返回 nil
+func VerifyUnsafe() error {
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build echo_debug
+
+/**
+ * Description：
+ * FileName：debug_echo.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 00:48:52
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"hash/crc32"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// unsafeRecord 一次零拷贝转换的记录
+type unsafeRecord struct {
+	ptr      *byte
+	length   int
+	sum      uint32
+	location string
+}
+
+var (
+	unsafeLock    sync.Mutex
+	unsafeRecords []unsafeRecord
+)
+
+// track 记录共享内存的校验和以及调用 UnsafeToBytes/UnsafeToString 的位置
+func track(ptr *byte, length int) {
+	if length == 0 {
+		return
+	}
+	location := "unknown"
+	if _, file, line, ok := runtime.Caller(2); ok {
+		location = fmt.Sprintf("%s:%d", file, line)
+	}
+	r := unsafeRecord{
+		ptr:      ptr,
+		length:   length,
+		sum:      crc32.ChecksumIEEE(unsafe.Slice(ptr, length)),
+		location: location,
+	}
+	unsafeLock.Lock()
+	unsafeRecords = append(unsafeRecords, r)
+	unsafeLock.Unlock()
+}
+
+// VerifyUnsafe 检查此前所有零拷贝转换的共享内存是否被修改，检查后清空记录
+// 通常在测试结束时调用：
+//
+//	defer func() { require.NoError(t, stringx.VerifyUnsafe()) }()
+func VerifyUnsafe() error {
+	unsafeLock.Lock()
+	records := unsafeRecords
+	unsafeRecords = nil
+	unsafeLock.Unlock()
+
+	var errList []error
+	for _, r := range records {
+		if crc32.ChecksumIEEE(unsafe.Slice(r.ptr, r.length)) != r.sum {
+			errList = append(errList, errs.NewErrUnsafeMutation(r.location))
+		}
+	}
+	return errors.Join(errList...)
+}
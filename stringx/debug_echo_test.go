@@ -0,0 +1,84 @@
+//go:build echo_debug
+
+/**
+ * Description：
+ * FileName：debug_echo_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 01:02:27
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestVerifyUnsafe(t *testing.T) {
+	testCases := []struct {
+		name    string
+		misuse  func()
+		wantErr bool
+	}{
+		{
+			name: "read only",
+			misuse: func() {
+				bs := UnsafeToBytes(strings.Repeat("a", 8))
+				_ = bs[0]
+				_ = UnsafeToString([]byte("hello"))
+			},
+		},
+		{
+			name: "empty",
+			misuse: func() {
+				_ = UnsafeToBytes("")
+				_ = UnsafeToString(nil)
+			},
+		},
+		{
+			name: "modify string backed bytes",
+			misuse: func() {
+				// 使用运行时构造的字符串，字面量位于只读内存中，修改会直接崩溃
+				bs := UnsafeToBytes(strings.Repeat("a", 8))
+				bs[0] = 'b'
+			},
+			wantErr: true,
+		},
+		{
+			name: "reuse bytes after converting",
+			misuse: func() {
+				buf := []byte("hello")
+				_ = UnsafeToString(buf)
+				copy(buf, "world")
+			},
+			wantErr: true,
+		},
+		{
+			name: "builder append after string",
+			misuse: func() {
+				var b Builder
+				_, _ = b.WriteString("hello")
+				_ = b.String()
+				_, _ = b.WriteString(strings.Repeat("x", 1024))
+				_ = b.String()
+				b.Reset()
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_ = VerifyUnsafe()
+			tc.misuse()
+			err := VerifyUnsafe()
+			if tc.wantErr {
+				assert.ErrorContains(t, err, "debug_echo_test.go")
+			} else {
+				assert.NoError(t, err)
+			}
+			// 检查后记录被清空
+			assert.NoError(t, VerifyUnsafe())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+//go:build !echo_debug
+
+/**
+ * Description：
+ * FileName：debug_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 01:10:44
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestVerifyUnsafe(t *testing.T) {
+	// 非调试模式下不做任何检查
+	bs := UnsafeToBytes(strings.Repeat("a", 8))
+	bs[0] = 'b'
+	assert.NoError(t, VerifyUnsafe())
+}
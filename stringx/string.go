@@ -18,16 +18,18 @@ import (
 
 // UnsafeToBytes 非安全 string 转 []byte 他必须遵守上述规则
 // 将 string 转换为 []byte，但不复制底层数据（零复制）
+// 使用 echo_debug 构建标签时会记录转换结果的校验和，可以通过 VerifyUnsafe 检查是否被修改
 func UnsafeToBytes(val string) []byte {
-	sh := (*[2]uintptr)(unsafe.Pointer(&val))
-	bh := [3]uintptr{sh[0], sh[1], sh[1]}
-	return *(*[]byte)(unsafe.Pointer(&bh))
+	ptr := unsafe.StringData(val)
+	track(ptr, len(val))
+	return unsafe.Slice(ptr, len(val))
 }
 
 // UnsafeToString 非安全 []byte 转 string 他必须遵守上述规则
 // 将 []byte 转换为 string，但不复制底层数据（零复制）
+// 使用 echo_debug 构建标签时会记录转换结果的校验和，可以通过 VerifyUnsafe 检查是否被修改
 func UnsafeToString(val []byte) string {
-	bh := (*[3]uintptr)(unsafe.Pointer(&val))
-	sh := [2]uintptr{bh[0], bh[1]}
-	return *(*string)(unsafe.Pointer(&sh))
+	ptr := unsafe.SliceData(val)
+	track(ptr, len(val))
+	return unsafe.String(ptr, len(val))
 }
@@ -129,3 +129,9 @@ func TestUnsafeToString(t *testing.T) {
 		})
 	}
 }
+
+func TestUnsafeEmpty(t *testing.T) {
+	assert.Len(t, UnsafeToBytes(""), 0)
+	assert.Equal(t, "", UnsafeToString(nil))
+	assert.Equal(t, "", UnsafeToString([]byte{}))
+}
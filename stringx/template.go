@@ -0,0 +1,249 @@
+/**
+ * Description：
+ * FileName：template.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 01:31:05
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"fmt"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// MissingKeyPolicy 找不到占位符对应的值时的处理策略
+type MissingKeyPolicy uint8
+
+const (
+	// MissingKeyError 返回错误，默认策略
+	MissingKeyError MissingKeyPolicy = iota
+	// MissingKeyKeep 原样保留占位符，例如 "{name}"
+	MissingKeyKeep
+	// MissingKeyEmpty 替换为空字符串
+	MissingKeyEmpty
+)
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Template 预编译的插值模板，并发安全，可以重复使用
+//
+// 语法：
+//   - {name} 占位符，名称两侧的空白会被忽略
+//   - {user.Name} 通过 . 访问嵌套的 map 或结构体字段
+//   - {{ 和 }} 分别输出 { 和 }
+type Template struct {
+	format   string
+	segments []templateSegment
+	missing  MissingKeyPolicy
+	// literalLen 所有字面量的长度之和，用于预分配
+	literalLen int
+}
+
+// templateSegment 字面量或者占位符，path 为 nil 时表示字面量
+type templateSegment struct {
+	literal string
+	path    []string
+}
+
+// NewTemplate 解析 format 并创建 Template，语法错误时返回错误
+func NewTemplate(format string, opts ...option.Option[Template]) (*Template, error) {
+	t := &Template{
+		format:  format,
+		missing: MissingKeyError,
+	}
+	option.Apply(t, opts...)
+	if err := t.parse(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// WithMissingKey 设置找不到值时的处理策略
+func WithMissingKey(policy MissingKeyPolicy) option.Option[Template] {
+	return func(t *Template) {
+		t.missing = policy
+	}
+}
+
+// Interpolate 使用 data 替换 format 中的占位符，例如：
+//
+//	Interpolate("Hello {name}", map[string]any{"name": "echo"}) => "Hello echo"
+//
+// data 可以是 map（键为字符串类型）、结构体或者它们的指针
+// 结构体只能访问导出字段，精确匹配失败时会忽略大小写再匹配一次，例如 {name} 可以匹配 Name 字段
+// 需要重复使用同一个 format 时，使用 NewTemplate 预编译性能更好
+func Interpolate(format string, data any, opts ...option.Option[Template]) (string, error) {
+	t, err := NewTemplate(format, opts...)
+	if err != nil {
+		return "", err
+	}
+	return t.Execute(data)
+}
+
+// Execute 使用 data 渲染模板
+func (t *Template) Execute(data any) (string, error) {
+	var sb strings.Builder
+	sb.Grow(t.literalLen + 8*len(t.segments))
+	for _, seg := range t.segments {
+		if seg.path == nil {
+			sb.WriteString(seg.literal)
+			continue
+		}
+		val, ok := lookup(data, seg.path)
+		if ok {
+			writeValue(&sb, val)
+			continue
+		}
+		switch t.missing {
+		case MissingKeyKeep:
+			sb.WriteString(seg.literal)
+		case MissingKeyEmpty:
+		default:
+			return "", errs.NewErrKeyNotFound(strings.Join(seg.path, "."))
+		}
+	}
+	return sb.String(), nil
+}
+
+// String 返回原始的模板
+func (t *Template) String() string {
+	return t.format
+}
+
+func (t *Template) parse() error {
+	format := t.format
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			t.segments = append(t.segments, templateSegment{literal: literal.String()})
+			t.literalLen += literal.Len()
+			literal.Reset()
+		}
+	}
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		switch c {
+		case '{':
+			if i+1 < len(format) && format[i+1] == '{' {
+				literal.WriteByte('{')
+				i++
+				continue
+			}
+			end := strings.IndexByte(format[i+1:], '}')
+			if end < 0 {
+				return errs.NewErrInvalidTemplate(format, i)
+			}
+			raw := format[i : i+end+2]
+			name := strings.TrimSpace(raw[1 : len(raw)-1])
+			if name == "" || strings.ContainsRune(name, '{') {
+				return errs.NewErrInvalidTemplate(format, i)
+			}
+			path := strings.Split(name, ".")
+			for _, p := range path {
+				if p == "" {
+					return errs.NewErrInvalidTemplate(format, i)
+				}
+			}
+			flush()
+			t.segments = append(t.segments, templateSegment{literal: raw, path: path})
+			i += end + 1
+		case '}':
+			if i+1 < len(format) && format[i+1] == '}' {
+				literal.WriteByte('}')
+				i++
+				continue
+			}
+			return errs.NewErrInvalidTemplate(format, i)
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	flush()
+	return nil
+}
+
+// lookup 按照 path 逐级查找，支持 map[string]any、键为字符串类型的 map 以及结构体的导出字段
+func lookup(data any, path []string) (any, bool) {
+	cur := data
+	for _, key := range path {
+		if m, ok := cur.(map[string]any); ok {
+			if cur, ok = m[key]; !ok {
+				return nil, false
+			}
+			continue
+		}
+		v := indirect(reflect.ValueOf(cur))
+		switch v.Kind() {
+		case reflect.Map:
+			kt := v.Type().Key()
+			if kt.Kind() != reflect.String {
+				return nil, false
+			}
+			mv := v.MapIndex(reflect.ValueOf(key).Convert(kt))
+			if !mv.IsValid() {
+				return nil, false
+			}
+			cur = mv.Interface()
+		case reflect.Struct:
+			f := v.FieldByName(key)
+			if !f.IsValid() {
+				f = v.FieldByNameFunc(func(name string) bool {
+					return strings.EqualFold(name, key)
+				})
+			}
+			if !f.IsValid() || !f.CanInterface() {
+				return nil, false
+			}
+			cur = f.Interface()
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// indirect 解开指针和接口，遇到 nil 时返回无效的 reflect.Value
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func writeValue(sb *strings.Builder, val any) {
+	switch v := val.(type) {
+	case string:
+		sb.WriteString(v)
+	case []byte:
+		sb.Write(v)
+	case int:
+		sb.WriteString(strconv.Itoa(v))
+	case int64:
+		sb.WriteString(strconv.FormatInt(v, 10))
+	case bool:
+		sb.WriteString(strconv.FormatBool(v))
+	default:
+		// 非 nil 的指针输出指向的值，而不是地址；实现了 fmt.Stringer 或 error 的指针交给 fmt 处理
+		rv := reflect.ValueOf(val)
+		for rv.Kind() == reflect.Pointer && !rv.IsNil() &&
+			!rv.Type().Implements(stringerType) && !rv.Type().Implements(errorType) {
+			rv = rv.Elem()
+		}
+		if rv.IsValid() && rv.CanInterface() {
+			val = rv.Interface()
+		}
+		_, _ = fmt.Fprint(sb, val)
+	}
+}
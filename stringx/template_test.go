@@ -0,0 +1,206 @@
+/**
+ * Description：
+ * FileName：template_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 02:21:37
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"errors"
+	"github.com/carefuly/careful-echo/bean/option"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type tplAddress struct {
+	City string
+}
+
+type tplUser struct {
+	Name    string
+	Age     *int
+	Address *tplAddress
+	Tags    map[string]string
+	secret  string
+}
+
+func TestInterpolate(t *testing.T) {
+	age := 18
+	user := tplUser{
+		Name:    "Tom",
+		Age:     &age,
+		Address: &tplAddress{City: "上海"},
+		Tags:    map[string]string{"role": "admin"},
+		secret:  "hidden",
+	}
+	testCases := []struct {
+		name    string
+		format  string
+		data    any
+		opts    []option.Option[Template]
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "no placeholder",
+			format: "hello world",
+			want:   "hello world",
+		},
+		{
+			name:   "map",
+			format: "Hello {name}, you are {age}",
+			data:   map[string]any{"name": "echo", "age": 3},
+			want:   "Hello echo, you are 3",
+		},
+		{
+			name:   "trim space",
+			format: "Hello { name }!",
+			data:   map[string]any{"name": "echo"},
+			want:   "Hello echo!",
+		},
+		{
+			name:   "escape",
+			format: "{{name}} = {name}, {{}}",
+			data:   map[string]any{"name": "echo"},
+			want:   "{name} = echo, {}",
+		},
+		{
+			name:   "typed map",
+			format: "{a}-{b}",
+			data:   map[string]int{"a": 1, "b": 2},
+			want:   "1-2",
+		},
+		{
+			name:   "struct",
+			format: "{Name} {Age} {Address.City} {Tags.role}",
+			data:   user,
+			want:   "Tom 18 上海 admin",
+		},
+		{
+			name:   "struct pointer in map",
+			format: "{user.Name}@{user.Address.City}",
+			data:   map[string]any{"user": &user},
+			want:   "Tom@上海",
+		},
+		{
+			name:   "stringer and nil",
+			format: "{d}|{n}|{e}",
+			data:   map[string]any{"d": time.Second, "n": nil, "e": errors.New("boom")},
+			want:   "1s|<nil>|boom",
+		},
+		{
+			name:    "missing key",
+			format:  "Hello {name}",
+			data:    map[string]any{},
+			wantErr: errs.NewErrKeyNotFound("name"),
+		},
+		{
+			name:    "unexported field",
+			format:  "{secret}",
+			data:    user,
+			wantErr: errs.NewErrKeyNotFound("secret"),
+		},
+		{
+			name:    "nil pointer",
+			format:  "{Address.City}",
+			data:    tplUser{},
+			wantErr: errs.NewErrKeyNotFound("Address.City"),
+		},
+		{
+			name:    "nil data",
+			format:  "{name}",
+			wantErr: errs.NewErrKeyNotFound("name"),
+		},
+		{
+			name:   "missing key keep",
+			format: "Hello {name} {x.y}",
+			data:   map[string]any{"name": "echo"},
+			opts:   []option.Option[Template]{WithMissingKey(MissingKeyKeep)},
+			want:   "Hello echo {x.y}",
+		},
+		{
+			name:   "missing key empty",
+			format: "Hello {name}{x}!",
+			data:   map[string]any{"name": "echo"},
+			opts:   []option.Option[Template]{WithMissingKey(MissingKeyEmpty)},
+			want:   "Hello echo!",
+		},
+		{
+			name:    "unclosed",
+			format:  "Hello {name",
+			wantErr: errs.NewErrInvalidTemplate("Hello {name", 6),
+		},
+		{
+			name:    "unexpected close",
+			format:  "Hello }",
+			wantErr: errs.NewErrInvalidTemplate("Hello }", 6),
+		},
+		{
+			name:    "empty name",
+			format:  "Hello { }",
+			wantErr: errs.NewErrInvalidTemplate("Hello { }", 6),
+		},
+		{
+			name:    "nested brace",
+			format:  "{a{b}",
+			wantErr: errs.NewErrInvalidTemplate("{a{b}", 0),
+		},
+		{
+			name:    "empty path",
+			format:  "{a..b}",
+			wantErr: errs.NewErrInvalidTemplate("{a..b}", 0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Interpolate(tc.format, tc.data, tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	tpl, err := NewTemplate("{name}: {score}")
+	assert.NoError(t, err)
+	assert.Equal(t, "{name}: {score}", tpl.String())
+
+	res, err := tpl.Execute(map[string]any{"name": "a", "score": 1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, "a: 1.5", res)
+
+	res, err = tpl.Execute(struct {
+		Name  string
+		Score uint8
+	}{Name: "b", Score: 90})
+	assert.NoError(t, err)
+	assert.Equal(t, "b: 90", res)
+
+	_, err = NewTemplate("{")
+	assert.Equal(t, errs.NewErrInvalidTemplate("{", 0), err)
+}
+
+func BenchmarkTemplate(b *testing.B) {
+	data := map[string]any{"name": "echo", "age": 3}
+	tpl, err := NewTemplate("Hello {name}, you are {age}")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("Template", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = tpl.Execute(data)
+		}
+	})
+	b.Run("Interpolate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = Interpolate("Hello {name}, you are {age}", data)
+		}
+	})
+}
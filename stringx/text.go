@@ -0,0 +1,89 @@
+/**
+ * Description：
+ * FileName：text.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 22:41:26
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Truncate 按字符（rune）截断字符串，结果长度（包含 ellipsis）不超过 n
+// 当 ellipsis 的长度不小于 n 时，直接截取前 n 个字符，不再追加 ellipsis
+func Truncate(s string, n int, ellipsis string) string {
+	if n <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	rs := []rune(s)
+	el := utf8.RuneCountInString(ellipsis)
+	if el >= n {
+		return string(rs[:n])
+	}
+	return string(rs[:n-el]) + ellipsis
+}
+
+// Reverse 按字符（rune）反转字符串
+func Reverse(s string) string {
+	rs := []rune(s)
+	for i, j := 0, len(rs)-1; i < j; i, j = i+1, j-1 {
+		rs[i], rs[j] = rs[j], rs[i]
+	}
+	return string(rs)
+}
+
+// PadLeft 在左侧填充 pad，直到字符（rune）数量达到 length
+// 已经达到 length 时原样返回
+func PadLeft(s string, length int, pad rune) string {
+	n := length - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+	return strings.Repeat(string(pad), n) + s
+}
+
+// PadRight 在右侧填充 pad，直到字符（rune）数量达到 length
+// 已经达到 length 时原样返回
+func PadRight(s string, length int, pad rune) string {
+	n := length - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+	return s + strings.Repeat(string(pad), n)
+}
+
+// Mask 保留前 prefix 个和后 suffix 个字符，其余字符替换为 mask
+// 字符数量不足以同时保留前后缀时，全部替换为 mask，避免泄露
+func Mask(s string, prefix, suffix int, mask rune) string {
+	rs := []rune(s)
+	if prefix < 0 {
+		prefix = 0
+	}
+	if suffix < 0 {
+		suffix = 0
+	}
+	if prefix+suffix >= len(rs) {
+		prefix, suffix = 0, 0
+	}
+	for i := prefix; i < len(rs)-suffix; i++ {
+		rs[i] = mask
+	}
+	return string(rs)
+}
+
+// MaskPhone 手机号脱敏，保留前 3 位和后 4 位，例如 "13812345678" => "138****5678"
+func MaskPhone(s string) string {
+	return Mask(s, 3, 4, '*')
+}
+
+// MaskIDCard 身份证号脱敏，保留前 6 位和后 4 位
+func MaskIDCard(s string) string {
+	return Mask(s, 6, 4, '*')
+}
@@ -0,0 +1,188 @@
+/**
+ * Description：
+ * FileName：text_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 23:10:32
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		val      string
+		n        int
+		ellipsis string
+		want     string
+	}{
+		{
+			name: "non positive",
+			val:  "hello",
+			n:    0,
+			want: "",
+		},
+		{
+			name:     "short enough",
+			val:      "hello",
+			n:        5,
+			ellipsis: "...",
+			want:     "hello",
+		},
+		{
+			name:     "ascii",
+			val:      "hello world",
+			n:        8,
+			ellipsis: "...",
+			want:     "hello...",
+		},
+		{
+			name:     "chinese",
+			val:      "你好世界，欢迎",
+			n:        5,
+			ellipsis: "…",
+			want:     "你好世界…",
+		},
+		{
+			name:     "emoji",
+			val:      "😀😀😀😀",
+			n:        3,
+			ellipsis: "",
+			want:     "😀😀😀",
+		},
+		{
+			name:     "ellipsis too long",
+			val:      "hello",
+			n:        2,
+			ellipsis: "...",
+			want:     "he",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Truncate(tc.val, tc.n, tc.ellipsis))
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		name string
+		val  string
+		want string
+	}{
+		{
+			name: "empty",
+			val:  "",
+			want: "",
+		},
+		{
+			name: "ascii",
+			val:  "hello",
+			want: "olleh",
+		},
+		{
+			name: "unicode",
+			val:  "你好😀!",
+			want: "!😀好你",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Reverse(tc.val))
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	testCases := []struct {
+		name      string
+		val       string
+		length    int
+		pad       rune
+		wantLeft  string
+		wantRight string
+	}{
+		{
+			name:      "ascii",
+			val:       "42",
+			length:    5,
+			pad:       '0',
+			wantLeft:  "00042",
+			wantRight: "42000",
+		},
+		{
+			name:      "unicode",
+			val:       "你好",
+			length:    4,
+			pad:       '·',
+			wantLeft:  "··你好",
+			wantRight: "你好··",
+		},
+		{
+			name:      "long enough",
+			val:       "hello",
+			length:    3,
+			pad:       ' ',
+			wantLeft:  "hello",
+			wantRight: "hello",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantLeft, PadLeft(tc.val, tc.length, tc.pad))
+			assert.Equal(t, tc.wantRight, PadRight(tc.val, tc.length, tc.pad))
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	testCases := []struct {
+		name   string
+		val    string
+		prefix int
+		suffix int
+		want   string
+	}{
+		{
+			name:   "normal",
+			val:    "abcdefgh",
+			prefix: 2,
+			suffix: 2,
+			want:   "ab****gh",
+		},
+		{
+			name:   "unicode",
+			val:    "张三丰",
+			prefix: 1,
+			suffix: 0,
+			want:   "张**",
+		},
+		{
+			name:   "too short",
+			val:    "abc",
+			prefix: 2,
+			suffix: 2,
+			want:   "***",
+		},
+		{
+			name:   "negative",
+			val:    "abc",
+			prefix: -1,
+			suffix: 1,
+			want:   "**c",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Mask(tc.val, tc.prefix, tc.suffix, '*'))
+		})
+	}
+	assert.Equal(t, "138****5678", MaskPhone("13812345678"))
+	assert.Equal(t, "110101********1234", MaskIDCard("110101199001011234"))
+}
@@ -0,0 +1,62 @@
+/**
+ * Description：
+ * FileName：width.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 22:34:51
+ * Remark：
+ */
+
+package stringx
+
+import "unicode"
+
+// wideRanges 东亚宽字符（W/F）的主要区间，终端中占两列
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // 谚文字母
+	{0x2E80, 0x303E},   // CJK 部首、标点
+	{0x3041, 0x33FF},   // 假名、注音、CJK 兼容
+	{0x3400, 0x4DBF},   // CJK 扩展 A
+	{0x4E00, 0x9FFF},   // CJK 统一汉字
+	{0xA000, 0xA4CF},   // 彝文
+	{0xAC00, 0xD7A3},   // 谚文音节
+	{0xF900, 0xFAFF},   // CJK 兼容汉字
+	{0xFE30, 0xFE4F},   // CJK 兼容形式
+	{0xFF00, 0xFF60},   // 全角字符
+	{0xFFE0, 0xFFE6},   // 全角符号
+	{0x1F300, 0x1F64F}, // 表情符号
+	{0x1F900, 0x1F9FF}, // 补充表情符号
+	{0x20000, 0x2FFFD}, // CJK 扩展 B~F
+	{0x30000, 0x3FFFD}, // CJK 扩展 G
+}
+
+// RuneWidth 返回字符在等宽终端中的显示宽度
+// 控制字符、组合字符以及零宽字符为 0，东亚宽字符为 2，其余为 1
+func RuneWidth(r rune) int {
+	if r < 0x20 || (r >= 0x7F && r < 0xA0) {
+		return 0
+	}
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+	if r < wideRanges[0][0] {
+		return 1
+	}
+	for _, rg := range wideRanges {
+		if r < rg[0] {
+			break
+		}
+		if r <= rg[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// Width 返回字符串在等宽终端中的显示宽度，例如 "你好a" => 5
+func Width(s string) int {
+	w := 0
+	for _, r := range s {
+		w += RuneWidth(r)
+	}
+	return w
+}
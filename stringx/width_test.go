@@ -0,0 +1,73 @@
+/**
+ * Description：
+ * FileName：width_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/19 23:03:45
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWidth(t *testing.T) {
+	testCases := []struct {
+		name string
+		val  string
+		want int
+	}{
+		{
+			name: "empty",
+			val:  "",
+			want: 0,
+		},
+		{
+			name: "ascii",
+			val:  "hello",
+			want: 5,
+		},
+		{
+			name: "chinese",
+			val:  "你好a",
+			want: 5,
+		},
+		{
+			name: "japanese and korean",
+			val:  "こんにちは한국",
+			want: 14,
+		},
+		{
+			name: "fullwidth",
+			val:  "ＡＢ！",
+			want: 6,
+		},
+		{
+			name: "emoji",
+			val:  "😀!",
+			want: 3,
+		},
+		{
+			name: "combining and zero width",
+			val:  "e\u0301\u200b",
+			want: 1,
+		},
+		{
+			name: "control",
+			val:  "a\tb\n",
+			want: 2,
+		},
+		{
+			name: "latin extended",
+			val:  "Ünïcode",
+			want: 7,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Width(tc.val))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/**
+ * Description：
+ * FileName：wrap.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 02:05:48
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Wrap 按照显示宽度折行，每行的宽度不超过 width（参考 Width）
+// 英文等以空白分隔的单词不会被拆开，除非单词本身超过 width；中日韩等宽字符可以在任意两个字符之间折行
+// 连续的空白会被合并为一个空格，行首行尾的空白会被丢弃，原有的换行会被保留
+// width <= 0 时原样返回
+func Wrap(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	var sb strings.Builder
+	sb.Grow(len(text) + len(text)/width)
+	for i, line := range lines {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		w := &wrapper{sb: &sb, width: width}
+		w.wrapLine(line)
+	}
+	return sb.String()
+}
+
+type wrapper struct {
+	sb    *strings.Builder
+	width int
+	// cur 当前行已经使用的宽度
+	cur int
+	// space 上一个单词后面是否有空白
+	space bool
+}
+
+func (w *wrapper) wrapLine(line string) {
+	rs := []rune(line)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			w.space = true
+			i++
+		case RuneWidth(r) == 2:
+			w.writeWord(rs[i:i+1], 2)
+			i++
+		default:
+			j, ww := i, 0
+			for ; j < len(rs) && !unicode.IsSpace(rs[j]) && RuneWidth(rs[j]) != 2; j++ {
+				ww += RuneWidth(rs[j])
+			}
+			w.writeWord(rs[i:j], ww)
+			i = j
+		}
+	}
+}
+
+// writeWord 写入一个不可拆分的单词，超过 width 时按字符强制拆分
+func (w *wrapper) writeWord(word []rune, ww int) {
+	sep := 0
+	if w.space && w.cur > 0 {
+		sep = 1
+	}
+	w.space = false
+	if w.cur+sep+ww <= w.width {
+		if sep > 0 {
+			w.sb.WriteByte(' ')
+		}
+		w.sb.WriteString(string(word))
+		w.cur += sep + ww
+		return
+	}
+	if w.cur > 0 {
+		w.newLine()
+	}
+	if ww <= w.width {
+		w.sb.WriteString(string(word))
+		w.cur = ww
+		return
+	}
+	for _, r := range word {
+		rw := RuneWidth(r)
+		if w.cur+rw > w.width && w.cur > 0 {
+			w.newLine()
+		}
+		w.sb.WriteRune(r)
+		w.cur += rw
+	}
+}
+
+func (w *wrapper) newLine() {
+	w.sb.WriteByte('\n')
+	w.cur = 0
+}
@@ -0,0 +1,91 @@
+/**
+ * Description：
+ * FileName：wrap_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 02:40:12
+ * Remark：
+ */
+
+package stringx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	testCases := []struct {
+		name  string
+		text  string
+		width int
+		want  string
+	}{
+		{
+			name:  "non positive width",
+			text:  "hello world",
+			width: 0,
+			want:  "hello world",
+		},
+		{
+			name:  "fit",
+			text:  "hello world",
+			width: 11,
+			want:  "hello world",
+		},
+		{
+			name:  "words",
+			text:  "the quick brown fox jumps",
+			width: 10,
+			want:  "the quick\nbrown fox\njumps",
+		},
+		{
+			name:  "collapse spaces",
+			text:  "  hello    world  ",
+			width: 5,
+			want:  "hello\nworld",
+		},
+		{
+			name:  "long word",
+			text:  "abcdefghij xy",
+			width: 4,
+			want:  "abcd\nefgh\nij\nxy",
+		},
+		{
+			name:  "chinese",
+			text:  "你好世界欢迎使用",
+			width: 5,
+			want:  "你好\n世界\n欢迎\n使用",
+		},
+		{
+			name:  "mixed",
+			text:  "Go语言 is fun",
+			width: 6,
+			want:  "Go语言\nis fun",
+		},
+		{
+			name:  "keep newline",
+			text:  "ab cd\nef",
+			width: 2,
+			want:  "ab\ncd\nef",
+		},
+		{
+			name:  "width smaller than wide char",
+			text:  "你好",
+			width: 1,
+			want:  "你\n好",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Wrap(tc.text, tc.width)
+			assert.Equal(t, tc.want, res)
+			if tc.width <= 1 {
+				return
+			}
+			for _, line := range strings.Split(res, "\n") {
+				assert.LessOrEqual(t, Width(line), tc.width)
+			}
+		})
+	}
+}
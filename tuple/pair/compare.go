@@ -0,0 +1,34 @@
+/**
+ * Description：
+ * FileName：compare.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 09:12:26
+ * Remark：
+ */
+
+package pair
+
+// Compare 返回先比较 Key、Key 相等时再比较 Value 的比较函数
+// 比较函数的约定与 cmp.Compare 一致，可以直接用于 slices.SortFunc 等函数
+func Compare[K any, V any](cmpKey func(a, b K) int, cmpValue func(a, b V) int) func(a, b Pair[K, V]) int {
+	return func(a, b Pair[K, V]) int {
+		if c := cmpKey(a.Key, b.Key); c != 0 {
+			return c
+		}
+		return cmpValue(a.Value, b.Value)
+	}
+}
+
+// CompareByKey 返回只比较 Key 的比较函数
+func CompareByKey[K any, V any](cmp func(a, b K) int) func(a, b Pair[K, V]) int {
+	return func(a, b Pair[K, V]) int {
+		return cmp(a.Key, b.Key)
+	}
+}
+
+// CompareByValue 返回只比较 Value 的比较函数
+func CompareByValue[K any, V any](cmp func(a, b V) int) func(a, b Pair[K, V]) int {
+	return func(a, b Pair[K, V]) int {
+		return cmp(a.Value, b.Value)
+	}
+}
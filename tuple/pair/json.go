@@ -0,0 +1,49 @@
+/**
+ * Description：
+ * FileName：json.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 09:34:18
+ * Remark：
+ */
+
+package pair
+
+import (
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+)
+
+// MarshalJSON 将 Pair 序列化为两个元素的数组 [Key, Value]
+func (pair Pair[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{pair.Key, pair.Value})
+}
+
+// UnmarshalJSON 从两个元素的数组 [Key, Value] 中反序列化
+func (pair *Pair[K, V]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return errs.NewErrInvalidType("[Key, Value]", string(data))
+	}
+	var res Pair[K, V]
+	if err := json.Unmarshal(raw[0], &res.Key); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[1], &res.Value); err != nil {
+		return err
+	}
+	*pair = res
+	return nil
+}
+
+// MarshalText 与 MarshalJSON 一致，输出 [Key, Value]
+func (pair Pair[K, V]) MarshalText() ([]byte, error) {
+	return pair.MarshalJSON()
+}
+
+// UnmarshalText 与 UnmarshalJSON 一致
+func (pair *Pair[K, V]) UnmarshalText(data []byte) error {
+	return pair.UnmarshalJSON(data)
+}
@@ -24,6 +24,11 @@ func (pair *Pair[K, V]) Split() (K, V) {
 	return pair.Key, pair.Value
 }
 
+// Swap 返回交换 Key 和 Value 后的新 Pair
+func (pair *Pair[K, V]) Swap() Pair[V, K] {
+	return NewPair(pair.Value, pair.Key)
+}
+
 func NewPair[K any, V any](
 	key K,
 	value V,
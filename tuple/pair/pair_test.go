@@ -0,0 +1,227 @@
+/**
+ * Description：
+ * FileName：pair_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 10:05:39
+ * Remark：
+ */
+
+package pair
+
+import (
+	"cmp"
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPairSwap(t *testing.T) {
+	p := NewPair("a", 1)
+	assert.Equal(t, NewPair(1, "a"), p.Swap())
+}
+
+func TestCompare(t *testing.T) {
+	pairs := []Pair[string, int]{
+		NewPair("b", 1),
+		NewPair("a", 2),
+		NewPair("b", 0),
+		NewPair("a", 1),
+	}
+	testCases := []struct {
+		name string
+		cmp  func(a, b Pair[string, int]) int
+		want []Pair[string, int]
+	}{
+		{
+			name: "key then value",
+			cmp:  Compare(strings.Compare, cmp.Compare[int]),
+			want: []Pair[string, int]{
+				NewPair("a", 1),
+				NewPair("a", 2),
+				NewPair("b", 0),
+				NewPair("b", 1),
+			},
+		},
+		{
+			name: "key only",
+			cmp:  CompareByKey[string, int](strings.Compare),
+			want: []Pair[string, int]{
+				NewPair("a", 2),
+				NewPair("a", 1),
+				NewPair("b", 1),
+				NewPair("b", 0),
+			},
+		},
+		{
+			name: "value only",
+			cmp:  CompareByValue[string](cmp.Compare[int]),
+			want: []Pair[string, int]{
+				NewPair("b", 0),
+				NewPair("b", 1),
+				NewPair("a", 1),
+				NewPair("a", 2),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := slices.Clone(pairs)
+			slices.SortStableFunc(res, tc.cmp)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	testCases := []struct {
+		name   string
+		keys   []string
+		values []int
+		want   []Pair[string, int]
+	}{
+		{
+			name: "nil",
+			want: []Pair[string, int]{},
+		},
+		{
+			name:   "same length",
+			keys:   []string{"a", "b"},
+			values: []int{1, 2},
+			want:   []Pair[string, int]{NewPair("a", 1), NewPair("b", 2)},
+		},
+		{
+			name:   "shorter values",
+			keys:   []string{"a", "b", "c"},
+			values: []int{1},
+			want:   []Pair[string, int]{NewPair("a", 1)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Zip(tc.keys, tc.values)
+			assert.Equal(t, tc.want, res)
+			keys, values := Unzip(res)
+			assert.Equal(t, len(res), len(keys))
+			assert.Equal(t, len(res), len(values))
+			assert.Equal(t, tc.want, Zip(keys, values))
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		m    map[string]int
+		want []Pair[string, int]
+	}{
+		{
+			name: "nil",
+			want: []Pair[string, int]{},
+		},
+		{
+			name: "sorted by key",
+			m:    map[string]int{"c": 3, "a": 1, "b": 2},
+			want: []Pair[string, int]{NewPair("a", 1), NewPair("b", 2), NewPair("c", 3)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FromMap(tc.m)
+			assert.Equal(t, tc.want, res)
+			back := ToMap(res)
+			assert.Equal(t, len(tc.m), len(back))
+			for k, v := range tc.m {
+				assert.Equal(t, v, back[k])
+			}
+		})
+	}
+
+	// 自定义顺序
+	res := FromMapFunc(map[string]int{"a": 1, "b": 2}, func(a, b string) int {
+		return strings.Compare(b, a)
+	})
+	assert.Equal(t, []Pair[string, int]{NewPair("b", 2), NewPair("a", 1)}, res)
+
+	// Key 重复时后面的覆盖前面的
+	assert.Equal(t, map[string]int{"a": 2}, ToMap([]Pair[string, int]{NewPair("a", 1), NewPair("a", 2)}))
+}
+
+func TestPairJSON(t *testing.T) {
+	type wrapper struct {
+		P  Pair[string, int]    `json:"p"`
+		PP *Pair[int, []string] `json:"pp"`
+	}
+	w := wrapper{
+		P:  NewPair("a", 1),
+		PP: &Pair[int, []string]{Key: 2, Value: []string{"x"}},
+	}
+	data, err := json.Marshal(w)
+	require.NoError(t, err)
+	assert.Equal(t, `{"p":["a",1],"pp":[2,["x"]]}`, string(data))
+
+	var res wrapper
+	require.NoError(t, json.Unmarshal(data, &res))
+	assert.Equal(t, w, res)
+
+	testCases := []struct {
+		name    string
+		data    string
+		want    Pair[string, int]
+		wantErr error
+	}{
+		{
+			name: "normal",
+			data: `["b", 2]`,
+			want: NewPair("b", 2),
+		},
+		{
+			name:    "too short",
+			data:    `["b"]`,
+			wantErr: errs.NewErrInvalidType("[Key, Value]", `["b"]`),
+		},
+		{
+			name:    "too long",
+			data:    `["b", 2, 3]`,
+			wantErr: errs.NewErrInvalidType("[Key, Value]", `["b", 2, 3]`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var p Pair[string, int]
+			err := json.Unmarshal([]byte(tc.data), &p)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, p)
+		})
+	}
+
+	// 类型不匹配或者不是数组
+	var p Pair[string, int]
+	assert.Error(t, json.Unmarshal([]byte(`[1, 2]`), &p))
+	assert.Error(t, json.Unmarshal([]byte(`{"Key": "a"}`), &p))
+}
+
+func TestPairText(t *testing.T) {
+	p := NewPair("a", 1)
+	data, err := p.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, `["a",1]`, string(data))
+
+	var res Pair[string, int]
+	require.NoError(t, res.UnmarshalText(data))
+	assert.Equal(t, p, res)
+
+	// 作为 map 的 Key 时使用 MarshalText
+	data, err = json.Marshal(map[Pair[string, int]]bool{p: true})
+	require.NoError(t, err)
+	assert.Equal(t, `{"[\"a\",1]":true}`, string(data))
+
+	// 反序列化 map 的 Key 时使用 UnmarshalText
+	var m map[Pair[string, int]]bool
+	require.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, map[Pair[string, int]]bool{p: true}, m)
+	assert.Error(t, json.Unmarshal([]byte(`{"a":true}`), &m))
+}
@@ -0,0 +1,62 @@
+/**
+ * Description：
+ * FileName：slice.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 09:20:47
+ * Remark：
+ */
+
+package pair
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Zip 将两个切片按下标一一组合为 Pair，结果长度以较短的切片为准
+// 即使输入为 nil，也保证返回非 nil 的空切片
+func Zip[K any, V any](keys []K, values []V) []Pair[K, V] {
+	length := min(len(keys), len(values))
+	res := make([]Pair[K, V], length)
+	for i := 0; i < length; i++ {
+		res[i] = NewPair(keys[i], values[i])
+	}
+	return res
+}
+
+// Unzip 将 Pair 切片拆分为 Key 切片和 Value 切片，是 Zip 的逆操作
+// 即使输入为 nil，也保证两个返回值都是非 nil 的空切片
+func Unzip[K any, V any](pairs []Pair[K, V]) ([]K, []V) {
+	keys := make([]K, len(pairs))
+	values := make([]V, len(pairs))
+	for i := range pairs {
+		keys[i], values[i] = pairs[i].Split()
+	}
+	return keys, values
+}
+
+// FromMap 将 map 转换为按 Key 升序排列的 Pair 切片
+// 即使输入为 nil，也保证返回非 nil 的空切片
+func FromMap[K cmp.Ordered, V any](m map[K]V) []Pair[K, V] {
+	return FromMapFunc(m, cmp.Compare[K])
+}
+
+// FromMapFunc 将 map 转换为 Pair 切片，使用 cmp 比较 Key 决定顺序
+func FromMapFunc[K comparable, V any](m map[K]V, cmp func(a, b K) int) []Pair[K, V] {
+	res := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		res = append(res, NewPair(k, v))
+	}
+	slices.SortFunc(res, CompareByKey[K, V](cmp))
+	return res
+}
+
+// ToMap 将 Pair 切片转换为 map，Key 重复时后面的 Value 覆盖前面的
+// 即使输入为 nil，也保证返回非 nil 的空 map
+func ToMap[K comparable, V any](pairs []Pair[K, V]) map[K]V {
+	res := make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		res[p.Key] = p.Value
+	}
+	return res
+}
@@ -0,0 +1,112 @@
+/**
+ * Description：
+ * FileName：triple.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 09:51:02
+ * Remark：
+ */
+
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/carefuly/careful-echo/internal/errs"
+)
+
+// Triple 三元组
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{
+		First:  first,
+		Second: second,
+		Third:  third,
+	}
+}
+
+func (t *Triple[A, B, C]) String() string {
+	return fmt.Sprintf("<%#v, %#v, %#v>", t.First, t.Second, t.Third)
+}
+
+// Split 方法将三个元素作为返回参数传出
+func (t *Triple[A, B, C]) Split() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// MarshalJSON 将 Triple 序列化为三个元素的数组
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON 从三个元素的数组中反序列化
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	var res Triple[A, B, C]
+	if err := unmarshalArray(data, "[First, Second, Third]", &res.First, &res.Second, &res.Third); err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
+
+// Quad 四元组
+type Quad[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+func NewQuad[A any, B any, C any, D any](first A, second B, third C, fourth D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{
+		First:  first,
+		Second: second,
+		Third:  third,
+		Fourth: fourth,
+	}
+}
+
+func (q *Quad[A, B, C, D]) String() string {
+	return fmt.Sprintf("<%#v, %#v, %#v, %#v>", q.First, q.Second, q.Third, q.Fourth)
+}
+
+// Split 方法将四个元素作为返回参数传出
+func (q *Quad[A, B, C, D]) Split() (A, B, C, D) {
+	return q.First, q.Second, q.Third, q.Fourth
+}
+
+// MarshalJSON 将 Quad 序列化为四个元素的数组
+func (q Quad[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]any{q.First, q.Second, q.Third, q.Fourth})
+}
+
+// UnmarshalJSON 从四个元素的数组中反序列化
+func (q *Quad[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	var res Quad[A, B, C, D]
+	if err := unmarshalArray(data, "[First, Second, Third, Fourth]", &res.First, &res.Second, &res.Third, &res.Fourth); err != nil {
+		return err
+	}
+	*q = res
+	return nil
+}
+
+// unmarshalArray 将 JSON 数组按顺序反序列化到 dst 中，数组长度必须与 dst 一致
+func unmarshalArray(data []byte, want string, dst ...any) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(dst) {
+		return errs.NewErrInvalidType(want, string(data))
+	}
+	for i, d := range dst {
+		if err := json.Unmarshal(raw[i], d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/**
+ * Description：
+ * FileName：triple_test.go
+ * Author：CJiaの用心
+ * Create：2026/10/20 10:24:51
+ * Remark：
+ */
+
+package tuple
+
+import (
+	"encoding/json"
+	"github.com/carefuly/careful-echo/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple("a", 1, true)
+	a, b, c := tr.Split()
+	assert.Equal(t, "a", a)
+	assert.Equal(t, 1, b)
+	assert.Equal(t, true, c)
+	assert.Equal(t, `<"a", 1, true>`, tr.String())
+}
+
+func TestTripleJSON(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    string
+		want    Triple[string, int, bool]
+		wantErr error
+	}{
+		{
+			name: "normal",
+			data: `["a",1,true]`,
+			want: NewTriple("a", 1, true),
+		},
+		{
+			name:    "wrong length",
+			data:    `["a",1]`,
+			wantErr: errs.NewErrInvalidType("[First, Second, Third]", `["a",1]`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var res Triple[string, int, bool]
+			err := json.Unmarshal([]byte(tc.data), &res)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+			data, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.data, string(data))
+		})
+	}
+}
+
+func TestQuad(t *testing.T) {
+	q := NewQuad("a", 1, true, 1.5)
+	a, b, c, d := q.Split()
+	assert.Equal(t, "a", a)
+	assert.Equal(t, 1, b)
+	assert.Equal(t, true, c)
+	assert.Equal(t, 1.5, d)
+	assert.Equal(t, `<"a", 1, true, 1.5>`, q.String())
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	assert.Equal(t, `["a",1,true,1.5]`, string(data))
+
+	var res Quad[string, int, bool, float64]
+	require.NoError(t, json.Unmarshal(data, &res))
+	assert.Equal(t, q, res)
+	assert.Equal(t, errs.NewErrInvalidType("[First, Second, Third, Fourth]", `[1]`), json.Unmarshal([]byte(`[1]`), &res))
+}